@@ -0,0 +1,69 @@
+// cmd/backend.go
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// gistBackend publishes posts as GitHub Gists. It is the only backend so
+// far, and the one assumed when a config or post doesn't name any.
+const gistBackend = "github"
+
+// supportedBackends lists the backends posts can be published to.
+var supportedBackends = []string{gistBackend}
+
+var configSetBackendCmd = &cobra.Command{
+	Use:   "set-backend <name>",
+	Short: "Choose where posts are published",
+	Long: `Choose the backend new posts are published to, stored as backend in
+.gblog/config.json. Posts that are already published keep the backend
+recorded in their .meta.json.
+
+Supported backends: github`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setConfigValue("backend", args[0])
+	},
+}
+
+func init() {
+	configCmd.AddCommand(configSetBackendCmd)
+}
+
+// validateBackend returns an error if name isn't a supported backend.
+// The empty name stands for the default.
+func validateBackend(name string) error {
+	if name == "" {
+		return nil
+	}
+	for _, backend := range supportedBackends {
+		if name == backend {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported backend %q (supported: %s)", name, strings.Join(supportedBackends, ", "))
+}
+
+// backend returns the backend new posts are published to.
+func (c Config) backend() string {
+	if c.Backend == "" {
+		return gistBackend
+	}
+	return c.Backend
+}
+
+// postBackend returns the backend a post was published to, falling back
+// to the config's for posts that haven't been published.
+func postBackend(meta PostMeta, config Config) string {
+	if meta.Backend != "" {
+		return meta.Backend
+	}
+	if meta.RemoteID != "" {
+		// Posts published before backends existed are all gists
+		return gistBackend
+	}
+	return config.backend()
+}
@@ -0,0 +1,138 @@
+// cmd/backup.go
+package cmd
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// backupFileName is the gist file holding the base64-encoded export.
+// Gists only store text, so the zip can't be uploaded as-is.
+const backupFileName = "gblog-backup.zip.b64"
+
+// BackupInfo records the most recent backup gist.
+type BackupInfo struct {
+	GistID    string    `json:"gist_id"`
+	GistURL   string    `json:"gist_url"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Back up all posts to a secret gist",
+	Long: `Export all posts and upload the archive to a secret gist.
+
+The zip is stored base64-encoded as gblog-backup.zip.b64, since gists only
+hold text. Restore it with:
+
+  base64 -d gblog-backup.zip.b64 > gblog-backup.zip
+
+The gist is recorded under last_backup in the config. Use --update to
+overwrite that gist instead of creating a new one.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		update, _ := cmd.Flags().GetBool("update")
+		return backupPosts(update)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(backupCmd)
+	backupCmd.Flags().BoolP("update", "u", false, "Overwrite the previous backup gist")
+}
+
+func backupPosts(update bool) error {
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	if err := checkGHAuth(); err != nil {
+		return err
+	}
+
+	tmpDir, err := os.MkdirTemp("", "gblog-backup-")
+	if err != nil {
+		return fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	archive, err := exportPosts(filepath.Join(tmpDir, "gblog-backup.zip"), exportOptions{})
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(archive)
+	if err != nil {
+		return fmt.Errorf("failed to read archive: %w", err)
+	}
+	content := wrapLines(base64.StdEncoding.EncodeToString(data), 76)
+
+	now := time.Now().UTC()
+	description := fmt.Sprintf("gblog backup of %s (%s)", config.RepoName, now.Format("2006-01-02 15:04 MST"))
+
+	if update && config.LastBackup == nil {
+		logInfo("No previous backup recorded; creating a new gist.")
+		update = false
+	}
+
+	var backup BackupInfo
+	if update {
+		logInfo(fmt.Sprintf("📤 Updating backup gist %s...", config.LastBackup.GistID), "gist_id", config.LastBackup.GistID)
+		if _, err := patchGist(config.LastBackup.GistID, &description, map[string]*gistFile{
+			backupFileName: {Content: content},
+		}); err != nil {
+			return fmt.Errorf("failed to update backup gist: %w", err)
+		}
+		backup = *config.LastBackup
+	} else {
+		logInfo("📤 Uploading backup to a secret gist...")
+		output, err := ghAPI(map[string]interface{}{
+			"description": description,
+			"public":      false,
+			"files":       map[string]map[string]string{backupFileName: {"content": content}},
+		}, "-X", "POST", "gists")
+		if err != nil {
+			return fmt.Errorf("failed to create backup gist: %w", err)
+		}
+
+		var gist gistResponse
+		if err := json.Unmarshal(output, &gist); err != nil {
+			return fmt.Errorf("failed to parse gist response: %w", err)
+		}
+		backup = BackupInfo{GistID: gist.ID, GistURL: gist.HTMLURL}
+	}
+
+	backup.CreatedAt = now
+	config.LastBackup = &backup
+	if err := saveConfig(config); err != nil {
+		return err
+	}
+
+	recordHistory("backup", "", backup.GistID, backup.GistURL)
+
+	logInfo("✅ Backup complete!")
+	logInfo(fmt.Sprintf("🔗 Gist URL: %s", backup.GistURL), "gist_url", backup.GistURL)
+
+	return nil
+}
+
+// wrapLines splits s into lines of at most width characters.
+func wrapLines(s string, width int) string {
+	var b strings.Builder
+	for len(s) > width {
+		b.WriteString(s[:width])
+		b.WriteByte('\n')
+		s = s[width:]
+	}
+	b.WriteString(s)
+	b.WriteByte('\n')
+	return b.String()
+}
@@ -0,0 +1,197 @@
+// cmd/comments.go
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// commentsCacheDir holds cached gist comments, one file per gist.
+const commentsCacheDir = ".gblog/cache/comments"
+
+// commentsCacheTTL is how long cached comments are reused by default.
+const commentsCacheTTL = 10 * time.Minute
+
+var gistCommentsCmd = &cobra.Command{
+	Use:     "pull-comments <post-id>",
+	Aliases: []string{"comments"},
+	Short:   "Show comments on a post's gist",
+	Long: `Show the comments readers left on a published post's gist, oldest first.
+
+Comments are cached in .gblog/cache/comments for --ttl (10 minutes by
+default); use --refresh to fetch them again right away.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ttl, _ := cmd.Flags().GetDuration("ttl")
+		refresh, _ := cmd.Flags().GetBool("refresh")
+		if refresh {
+			ttl = 0
+		}
+		return showGistComments(args[0], ttl)
+	},
+}
+
+// gistComment is a comment in the GitHub gist comments API.
+type gistComment struct {
+	ID        int64     `json:"id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	User      struct {
+		Login string `json:"login"`
+	} `json:"user"`
+}
+
+// commentsCache is the on-disk cache of a gist's comments.
+type commentsCache struct {
+	FetchedAt time.Time     `json:"fetched_at"`
+	Comments  []gistComment `json:"comments"`
+}
+
+var (
+	commentAuthorStyle  = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(defaultTheme["accent"]))
+	markdownBoldPattern = regexp.MustCompile(`\*\*([^*]+)\*\*`)
+	markdownCodePattern = regexp.MustCompile("`([^`]+)`")
+)
+
+func init() {
+	gistCmd.AddCommand(gistCommentsCmd)
+	gistCommentsCmd.Flags().Duration("ttl", commentsCacheTTL, "How long cached comments are reused")
+	gistCommentsCmd.Flags().Bool("refresh", false, "Ignore the cache and fetch comments now")
+}
+
+func showGistComments(postID string, ttl time.Duration) error {
+	postDir, err := findPostDir(postID)
+	if err != nil {
+		return err
+	}
+
+	meta, err := loadPostMeta(postDir)
+	if err != nil {
+		return err
+	}
+
+	if meta.RemoteID == "" {
+		return fmt.Errorf("post %s has not been published", meta.ID)
+	}
+
+	comments, err := loadGistComments(meta.RemoteID, ttl)
+	if err != nil {
+		return err
+	}
+
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	dates := newDateFormatter(config, false)
+
+	fmt.Println(listTitleStyle.Render(fmt.Sprintf("💬 Comments on %s", meta.Title)))
+
+	if len(comments) == 0 {
+		fmt.Println("No comments yet.")
+		return nil
+	}
+
+	for _, comment := range comments {
+		fmt.Printf("%s %s\n", commentAuthorStyle.Render("@"+comment.User.Login), helpStyle.UnsetMargins().Render(dates.Format(comment.CreatedAt)))
+		fmt.Println(renderMarkdown(comment.Body))
+		fmt.Println()
+	}
+	fmt.Printf("Total: %d comments\n", len(comments))
+
+	return nil
+}
+
+// loadGistComments returns a gist's comments sorted oldest first, using
+// the cache when it is younger than ttl.
+func loadGistComments(gistID string, ttl time.Duration) ([]gistComment, error) {
+	cachePath := filepath.Join(commentsCacheDir, gistID+".json")
+
+	if ttl > 0 {
+		if data, err := os.ReadFile(cachePath); err == nil {
+			var cache commentsCache
+			if err := json.Unmarshal(data, &cache); err == nil && time.Since(cache.FetchedAt) < ttl {
+				return cache.Comments, nil
+			}
+		}
+	}
+
+	if err := checkGHAuth(); err != nil {
+		return nil, err
+	}
+
+	output, err := ghAPI(nil, "--paginate", "--slurp", fmt.Sprintf("gists/%s/comments", gistID))
+	if err != nil {
+		return nil, err
+	}
+
+	var pages [][]gistComment
+	if err := json.Unmarshal(output, &pages); err != nil {
+		return nil, fmt.Errorf("failed to parse comments: %w", err)
+	}
+	var comments []gistComment
+	for _, page := range pages {
+		comments = append(comments, page...)
+	}
+	sort.SliceStable(comments, func(i, j int) bool {
+		return comments[i].CreatedAt.Before(comments[j].CreatedAt)
+	})
+
+	// A failed cache write only costs a refetch next time
+	if err := os.MkdirAll(commentsCacheDir, 0755); err == nil {
+		if err := writeJSONAtomic(cachePath, commentsCache{FetchedAt: time.Now(), Comments: comments}); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to cache comments: %v\n", err)
+		}
+	}
+
+	return comments, nil
+}
+
+// renderMarkdown styles the common markdown of a comment for the
+// terminal: headings, quotes, lists, code blocks, bold, and inline code.
+func renderMarkdown(text string) string {
+	codeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(defaultTheme["draft"]))
+	boldStyle := lipgloss.NewStyle().Bold(true)
+	quoteStyle := helpStyle.UnsetMargins()
+
+	inline := func(line string) string {
+		line = markdownCodePattern.ReplaceAllStringFunc(line, func(m string) string {
+			return codeStyle.Render(strings.Trim(m, "`"))
+		})
+		return markdownBoldPattern.ReplaceAllStringFunc(line, func(m string) string {
+			return boldStyle.Render(strings.Trim(m, "*"))
+		})
+	}
+
+	var out []string
+	inCode := false
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "```"):
+			inCode = !inCode
+			continue
+		case inCode:
+			out = append(out, "    "+codeStyle.Render(line))
+		case strings.HasPrefix(trimmed, "#"):
+			out = append(out, "  "+boldStyle.Render(strings.TrimSpace(strings.TrimLeft(trimmed, "#"))))
+		case strings.HasPrefix(trimmed, ">"):
+			out = append(out, "  "+quoteStyle.Render("│ "+strings.TrimSpace(strings.TrimPrefix(trimmed, ">"))))
+		case strings.HasPrefix(trimmed, "- "), strings.HasPrefix(trimmed, "* "):
+			out = append(out, "  • "+inline(trimmed[2:]))
+		default:
+			out = append(out, "  "+inline(line))
+		}
+	}
+
+	return strings.TrimRight(strings.Join(out, "\n"), " \n")
+}
@@ -0,0 +1,390 @@
+// cmd/config.go
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "View and change blog configuration",
+	Long:  `View and change the blog configuration stored in .gblog/config.json.`,
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set a configuration value",
+	Long: `Set a configuration value in .gblog/config.json.
+
+Keys use the JSON field names from the config file. Nested map values
+use dot notation, for example:
+
+  gblog config set default_public false
+  gblog config set theme.published "#00ff00"`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setConfigValue(args[0], args[1])
+	},
+}
+
+var configEditCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Edit the configuration in $EDITOR",
+	Long: `Open .gblog/config.json in $EDITOR and check it when the editor exits.
+
+Invalid JSON, values of the wrong type, and unknown keys are rejected
+with the line of the problem, and you can edit again or keep the
+previous config. The config file is only replaced once it is valid.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return editConfig()
+	},
+}
+
+var configShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Print the configuration",
+	Long: `Print the configuration in .gblog/config.json.
+
+With --effective, print the configuration gblog actually uses: unset
+values are filled in with their defaults and environment overrides
+(such as GBLOG_NO_BROWSER) are applied. Alongside it, "sources" says
+where each value came from: file, env, or default.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		effective, _ := cmd.Flags().GetBool("effective")
+		return showConfig(effective)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configSetCmd)
+	configCmd.AddCommand(configEditCmd)
+	configCmd.AddCommand(configShowCmd)
+	configShowCmd.Flags().Bool("effective", false, "Resolve defaults and environment overrides, noting each value's source")
+}
+
+// Sources reported by 'config show --effective'.
+const (
+	sourceFile    = "file"
+	sourceEnv     = "env"
+	sourceDefault = "default"
+)
+
+// effectiveConfig is the output of 'config show --effective'.
+type effectiveConfig struct {
+	Config  Config            `json:"config"`
+	Sources map[string]string `json:"sources"`
+}
+
+func showConfig(effective bool) error {
+	data, err := os.ReadFile(".gblog/config.json")
+	if os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+
+	if !effective {
+		os.Stdout.Write(data)
+		if !bytes.HasSuffix(data, []byte("\n")) {
+			fmt.Println()
+		}
+		return nil
+	}
+
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	var inFile map[string]json.RawMessage
+	if err := json.Unmarshal(data, &inFile); err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	resolved := resolveConfig(config, inFile)
+	out, err := json.MarshalIndent(resolved, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+// resolveConfig fills in the defaults gblog uses for unset values and
+// applies environment overrides, recording where each key's value came
+// from. inFile holds the keys present in the config file.
+func resolveConfig(config Config, inFile map[string]json.RawMessage) effectiveConfig {
+	sources := map[string]string{}
+	for _, key := range configKeys() {
+		if _, ok := inFile[key]; ok {
+			sources[key] = sourceFile
+		} else {
+			sources[key] = sourceDefault
+		}
+	}
+
+	theme := make(map[string]string, len(defaultTheme))
+	for key, color := range defaultTheme {
+		theme[key] = color
+	}
+	for key, color := range config.Theme {
+		theme[key] = color
+	}
+	config.Theme = theme
+
+	if config.Timezone == "" {
+		config.Timezone = time.Local.String()
+		sources["timezone"] = sourceDefault
+	}
+	if config.DateFormat == "" {
+		config.DateFormat = defaultDateFormat
+		sources["date_format"] = sourceDefault
+	}
+	if config.Backend == "" {
+		config.Backend = config.backend()
+		sources["backend"] = sourceDefault
+	}
+
+	openAfterPublish := config.openAfterPublish()
+	switch strings.ToLower(os.Getenv("GBLOG_NO_BROWSER")) {
+	case "1", "true", "yes":
+		openAfterPublish = false
+		sources["open_after_publish"] = sourceEnv + " (GBLOG_NO_BROWSER)"
+	}
+	config.OpenAfterPublish = &openAfterPublish
+
+	return effectiveConfig{Config: config, Sources: sources}
+}
+
+// editConfig edits a copy of the config in $EDITOR, replacing the real
+// file only when the edited copy parses and validates.
+func editConfig() error {
+	configPath := ".gblog/config.json"
+	original, err := os.ReadFile(configPath)
+	if os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+
+	tmpFile, err := os.CreateTemp(".gblog", "config-edit-*.json")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	tmpPath := tmpFile.Name()
+	defer os.Remove(tmpPath)
+	_, err = tmpFile.Write(original)
+	tmpFile.Close()
+	if err != nil {
+		return fmt.Errorf("failed to write temp file: %w", err)
+	}
+
+	for {
+		if err := openInEditor(tmpPath); err != nil {
+			return err
+		}
+
+		edited, err := os.ReadFile(tmpPath)
+		if err != nil {
+			return fmt.Errorf("failed to read edited config: %w", err)
+		}
+		if bytes.Equal(edited, original) {
+			fmt.Println("No changes.")
+			return nil
+		}
+
+		config, err := parseConfigStrict(edited)
+		if err == nil {
+			err = validateConfig(config)
+		}
+		if err == nil {
+			// The edited copy sits next to the config, so renaming it
+			// over the config replaces it atomically
+			if err := replaceWithEdited(tmpPath, configPath); err != nil {
+				return fmt.Errorf("failed to write config: %w", err)
+			}
+			fmt.Printf("✅ Saved %s\n", configPath)
+			return nil
+		}
+
+		fmt.Printf("❌ %v\n", err)
+		again, cerr := confirm("Edit again?")
+		if cerr != nil || !again {
+			return fmt.Errorf("config not changed: %w", err)
+		}
+	}
+}
+
+// replaceWithEdited syncs the edited file at tmpPath and renames it over
+// path with the usual config permissions.
+func replaceWithEdited(tmpPath, path string) error {
+	file, err := os.Open(tmpPath)
+	if err != nil {
+		return err
+	}
+	err = file.Sync()
+	file.Close()
+	if err != nil {
+		return err
+	}
+	if err := os.Chmod(tmpPath, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// parseConfigStrict decodes a config, rejecting unknown keys and
+// reporting the line of syntax and type errors.
+func parseConfigStrict(data []byte) (Config, error) {
+	var config Config
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(&config)
+	if err == nil {
+		return config, nil
+	}
+
+	var offset int64 = -1
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+	case errors.As(err, &typeErr):
+		offset = typeErr.Offset
+	}
+	if offset >= 0 && offset <= int64(len(data)) {
+		line := bytes.Count(data[:offset], []byte("\n")) + 1
+		return config, fmt.Errorf("invalid config on line %d: %w", line, err)
+	}
+
+	return config, fmt.Errorf("invalid config: %w", err)
+}
+
+func setConfigValue(key, value string) error {
+	// Check if gblog is initialized
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	// Work on a generic map so any field can be addressed by its JSON name
+	data, err := json.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return fmt.Errorf("failed to decode config: %w", err)
+	}
+
+	field, subKey, nested := strings.Cut(key, ".")
+	if !isConfigKey(field) {
+		return fmt.Errorf("unknown config key %q (valid keys: %s)", field, strings.Join(configKeys(), ", "))
+	}
+
+	// Try the value as JSON first (true, 3), then as a plain string, and
+	// round-trip through Config to validate its type
+	var updated Config
+	for _, candidate := range []interface{}{parseConfigValue(value), value} {
+		if nested {
+			section, _ := fields[field].(map[string]interface{})
+			if section == nil {
+				section = map[string]interface{}{}
+			}
+			section[subKey] = candidate
+			fields[field] = section
+		} else {
+			fields[field] = candidate
+		}
+
+		data, err = json.Marshal(fields)
+		if err != nil {
+			return fmt.Errorf("failed to encode config: %w", err)
+		}
+		updated = Config{}
+		if err = json.Unmarshal(data, &updated); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("invalid value for %s: %w", key, err)
+	}
+
+	if err := validateConfig(updated); err != nil {
+		return err
+	}
+
+	if err := saveConfig(updated); err != nil {
+		return err
+	}
+
+	logInfo(fmt.Sprintf("✅ Set %s = %s", key, value))
+	return nil
+}
+
+// validateConfig checks the config values that JSON decoding can't.
+func validateConfig(config Config) error {
+	if err := validateTheme(config.Theme); err != nil {
+		return err
+	}
+
+	if config.Timezone != "" {
+		if _, err := time.LoadLocation(config.Timezone); err != nil {
+			return fmt.Errorf("invalid timezone %q: %w", config.Timezone, err)
+		}
+	}
+
+	return validateBackend(config.Backend)
+}
+
+// parseConfigValue interprets value as JSON when possible (true, 3, "x"),
+// falling back to a plain string.
+func parseConfigValue(value string) interface{} {
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(value), &parsed); err == nil {
+		return parsed
+	}
+	return value
+}
+
+// configKeys returns the JSON field names of Config in sorted order.
+func configKeys() []string {
+	var keys []string
+	t := reflect.TypeOf(Config{})
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			keys = append(keys, name)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func isConfigKey(key string) bool {
+	for _, k := range configKeys() {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+// cmd/count.go
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var countCmd = &cobra.Command{
+	Use:   "count",
+	Short: "Print the number of matching posts",
+	Long: `Print the number of posts matching the same filters as 'gblog list',
+as a bare integer for scripts and CI checks:
+
+  gblog count --status published
+  gblog count --tag go --visibility public`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filter, err := readFilterFlags(cmd)
+		if err != nil {
+			return err
+		}
+		return countPosts(filter)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(countCmd)
+	addFilterFlags(countCmd)
+}
+
+func countPosts(filter postFilter) error {
+	// Check if gblog is initialized
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+
+	posts, err := loadPosts()
+	if err != nil {
+		return err
+	}
+
+	posts, err = filterPosts(posts, filter)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(len(posts))
+	return nil
+}
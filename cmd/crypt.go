@@ -0,0 +1,165 @@
+// cmd/crypt.go
+package cmd
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/x/term"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Encrypted archives start with encryptMagic, then a version byte, the
+// scrypt salt, and the AES-GCM nonce, followed by the sealed data.
+const (
+	encryptMagic   = "GBLOGENC"
+	encryptVersion = 1
+	encryptSaltLen = 16
+)
+
+// scrypt cost parameters for version 1 files (the recommended interactive
+// settings as of 2017).
+const (
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+// encryptFile encrypts src into dst with a key derived from passphrase.
+func encryptFile(src, dst string, passphrase []byte) error {
+	plaintext, err := os.ReadFile(src)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", src, err)
+	}
+
+	salt := make([]byte, encryptSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	gcm, err := newArchiveCipher(passphrase, salt)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	var out bytes.Buffer
+	out.WriteString(encryptMagic)
+	out.WriteByte(encryptVersion)
+	out.Write(salt)
+	out.Write(nonce)
+	// The header is authenticated along with the data
+	header := append([]byte(nil), out.Bytes()...)
+	out.Write(gcm.Seal(nil, nonce, plaintext, header))
+
+	if err := os.WriteFile(dst, out.Bytes(), 0600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", dst, err)
+	}
+	return nil
+}
+
+// decryptFile reverses encryptFile, writing the plain archive to dst.
+func decryptFile(src, dst string, passphrase []byte) error {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", src, err)
+	}
+
+	if !bytes.HasPrefix(data, []byte(encryptMagic)) {
+		return fmt.Errorf("%s is not an encrypted gblog archive", src)
+	}
+	rest := data[len(encryptMagic):]
+	if len(rest) < 1 || rest[0] != encryptVersion {
+		return fmt.Errorf("%s uses an unsupported encryption version", src)
+	}
+	rest = rest[1:]
+
+	if len(rest) < encryptSaltLen {
+		return fmt.Errorf("%s is truncated", src)
+	}
+	salt := rest[:encryptSaltLen]
+	gcm, err := newArchiveCipher(passphrase, salt)
+	if err != nil {
+		return err
+	}
+
+	headerLen := len(encryptMagic) + 1 + encryptSaltLen + gcm.NonceSize()
+	if len(data) < headerLen {
+		return fmt.Errorf("%s is truncated", src)
+	}
+	nonce := data[headerLen-gcm.NonceSize() : headerLen]
+
+	plaintext, err := gcm.Open(nil, nonce, data[headerLen:], data[:headerLen])
+	if err != nil {
+		return fmt.Errorf("failed to decrypt %s: wrong passphrase or corrupted file", src)
+	}
+
+	if err := os.WriteFile(dst, plaintext, 0600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", dst, err)
+	}
+	return nil
+}
+
+// newArchiveCipher derives an AES-256-GCM cipher from passphrase and salt.
+func newArchiveCipher(passphrase, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key(passphrase, salt, scryptN, scryptR, scryptP, 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// readPassphrase reads a passphrase from GBLOG_PASSPHRASE or, failing
+// that, from the terminal without echo. With confirm, it is asked twice.
+func readPassphrase(confirm bool) ([]byte, error) {
+	if env := os.Getenv("GBLOG_PASSPHRASE"); env != "" {
+		return []byte(env), nil
+	}
+
+	if !term.IsTerminal(os.Stdin.Fd()) {
+		return nil, fmt.Errorf("passphrase required but stdin is not a terminal (set GBLOG_PASSPHRASE)")
+	}
+
+	ask := func(prompt string) ([]byte, error) {
+		fmt.Fprint(os.Stderr, prompt)
+		passphrase, err := term.ReadPassword(os.Stdin.Fd())
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read passphrase: %w", err)
+		}
+		return passphrase, nil
+	}
+
+	passphrase, err := ask("Passphrase: ")
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(string(passphrase)) == "" {
+		return nil, fmt.Errorf("passphrase can't be empty")
+	}
+
+	if confirm {
+		again, err := ask("Repeat passphrase: ")
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(passphrase, again) {
+			return nil, fmt.Errorf("passphrases don't match")
+		}
+	}
+
+	return passphrase, nil
+}
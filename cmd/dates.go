@@ -0,0 +1,200 @@
+// cmd/dates.go
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// defaultDateFormat is the layout used when Config.DateFormat is unset.
+const defaultDateFormat = "2006-01-02"
+
+// dateFormatter renders stored (UTC) timestamps for display using the
+// configured timezone and layout, or as relative times.
+type dateFormatter struct {
+	location *time.Location
+	layout   string
+	relative bool
+	now      time.Time
+}
+
+func newDateFormatter(config Config, relative bool) dateFormatter {
+	f := dateFormatter{
+		location: time.Local,
+		layout:   defaultDateFormat,
+		relative: relative,
+		now:      time.Now(),
+	}
+
+	if config.Timezone != "" {
+		loc, err := time.LoadLocation(config.Timezone)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: invalid timezone %q, using local time\n", config.Timezone)
+		} else {
+			f.location = loc
+		}
+	}
+
+	if config.DateFormat != "" {
+		f.layout = config.DateFormat
+	}
+
+	return f
+}
+
+// Format renders t in the configured timezone and layout.
+func (f dateFormatter) Format(t time.Time) string {
+	if f.relative {
+		return relativeTime(t, f.now)
+	}
+	return t.In(f.location).Format(f.layout)
+}
+
+// In converts t to the configured timezone.
+func (f dateFormatter) In(t time.Time) time.Time {
+	return t.In(f.location)
+}
+
+// relativeTime describes t relative to now, e.g. "3 days ago".
+func relativeTime(t, now time.Time) string {
+	d := now.Sub(t)
+	if d < 0 {
+		return "in the future"
+	}
+
+	plural := func(n int, unit string) string {
+		if n == 1 {
+			return fmt.Sprintf("1 %s ago", unit)
+		}
+		return fmt.Sprintf("%d %ss ago", n, unit)
+	}
+
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return plural(int(d.Minutes()), "minute")
+	case d < 24*time.Hour:
+		return plural(int(d.Hours()), "hour")
+	case d < 30*24*time.Hour:
+		return plural(int(d.Hours()/24), "day")
+	case d < 365*24*time.Hour:
+		return plural(int(d.Hours()/(24*30)), "month")
+	default:
+		return plural(int(d.Hours()/(24*365)), "year")
+	}
+}
+
+// dateRange is a half-open [since, until) window; zero bounds are open.
+type dateRange struct {
+	since time.Time
+	until time.Time
+}
+
+// Contains reports whether t falls within the range.
+func (r dateRange) Contains(t time.Time) bool {
+	if !r.since.IsZero() && t.Before(r.since) {
+		return false
+	}
+	if !r.until.IsZero() && !t.Before(r.until) {
+		return false
+	}
+	return true
+}
+
+// datePeriods are the --today, --this-week, ... shortcut flags.
+var datePeriods = []string{"today", "this-week", "this-month", "this-year"}
+
+// addDateRangeFlags registers the --since and --until flags, and the
+// period shortcuts that stand for a range ending now.
+func addDateRangeFlags(cmd *cobra.Command) {
+	cmd.Flags().String("since", "", "Only include posts created on or after this date (2006-01-02 or RFC3339)")
+	cmd.Flags().String("until", "", "Only include posts created on or before this date (2006-01-02 or RFC3339)")
+	cmd.Flags().Bool("today", false, "Only include posts created today")
+	cmd.Flags().Bool("this-week", false, "Only include posts created this week (since Monday)")
+	cmd.Flags().Bool("this-month", false, "Only include posts created this month")
+	cmd.Flags().Bool("this-year", false, "Only include posts created this year")
+	cmd.MarkFlagsMutuallyExclusive(append([]string{"since"}, datePeriods...)...)
+	cmd.MarkFlagsMutuallyExclusive(append([]string{"until"}, datePeriods...)...)
+}
+
+// readDateRangeFlags parses --since and --until. Plain dates are read in
+// the configured timezone and --until includes the whole day.
+func readDateRangeFlags(cmd *cobra.Command) (dateRange, error) {
+	var r dateRange
+
+	loc := time.Local
+	if config, err := loadConfig(); err == nil {
+		loc = newDateFormatter(config, false).location
+	}
+
+	for _, period := range datePeriods {
+		if set, _ := cmd.Flags().GetBool(period); set {
+			return periodRange(period, time.Now().In(loc)), nil
+		}
+	}
+
+	since, _ := cmd.Flags().GetString("since")
+	if since != "" {
+		t, _, err := parseDateBound(since, loc)
+		if err != nil {
+			return r, fmt.Errorf("invalid --since: %w", err)
+		}
+		r.since = t
+	}
+
+	until, _ := cmd.Flags().GetString("until")
+	if until != "" {
+		t, dateOnly, err := parseDateBound(until, loc)
+		if err != nil {
+			return r, fmt.Errorf("invalid --until: %w", err)
+		}
+		if dateOnly {
+			r.until = t.AddDate(0, 0, 1)
+		} else {
+			r.until = t.Add(time.Nanosecond)
+		}
+	}
+
+	if !r.since.IsZero() && !r.until.IsZero() && !r.since.Before(r.until) {
+		return r, fmt.Errorf("--since must be before --until")
+	}
+
+	return r, nil
+}
+
+// periodRange returns the range from the start of the named period
+// containing now (today, this-week, this-month, or this-year) until the
+// start of the next one. Weeks start on Monday.
+func periodRange(period string, now time.Time) dateRange {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch period {
+	case "this-week":
+		since := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+		return dateRange{since: since, until: since.AddDate(0, 0, 7)}
+	case "this-month":
+		since := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		return dateRange{since: since, until: since.AddDate(0, 1, 0)}
+	case "this-year":
+		since := time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, now.Location())
+		return dateRange{since: since, until: since.AddDate(1, 0, 0)}
+	default:
+		return dateRange{since: today, until: today.AddDate(0, 0, 1)}
+	}
+}
+
+// parseDateBound parses a 2006-01-02 date (in loc) or an RFC3339 timestamp,
+// reporting whether the value was a plain date.
+func parseDateBound(value string, loc *time.Location) (time.Time, bool, error) {
+	if t, err := time.ParseInLocation("2006-01-02", value, loc); err == nil {
+		return t, true, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, false, nil
+	}
+	return time.Time{}, false, fmt.Errorf("%q is not a date like 2006-01-02 or an RFC3339 timestamp", value)
+}
@@ -0,0 +1,161 @@
+// cmd/doctor.go
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/spf13/cobra"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check that gblog is set up correctly",
+	Long: `Check the tools and files gblog depends on and print a checklist
+with a hint for each problem: the GitHub CLI and its login, git, and
+the blog project and its config.
+
+Exits with status 6 if any critical check fails.
+
+--repair-gitignore rewrites the gblog section of .gitignore (between
+its "# BEGIN gblog" and "# END gblog" markers) to list exactly the
+private posts, dropping entries for posts made public or deleted. Post
+entries elsewhere in the file move into the section; other lines are
+kept as they are. .gblog/cache/ and .gblog/.env are added if missing.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if repair, _ := cmd.Flags().GetBool("repair-gitignore"); repair {
+			return repairGitignore()
+		}
+		return runDoctor()
+	},
+}
+
+// doctorCheck is a single setup check. A failing critical check makes
+// doctor exit nonzero; others only warn.
+type doctorCheck struct {
+	name     string
+	critical bool
+	run      func() (ok bool, hint string)
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+	doctorCmd.Flags().Bool("repair-gitignore", false, "Make .gitignore list exactly the private posts")
+}
+
+func runDoctor() error {
+	checks := []doctorCheck{
+		{"GitHub CLI (gh) installed", true, func() (bool, string) {
+			return isCommandAvailable("gh"), "Install it from https://cli.github.com/"
+		}},
+		{"GitHub CLI authenticated", true, func() (bool, string) {
+			if !isCommandAvailable("gh") {
+				return false, "Install gh first, then run 'gh auth login'"
+			}
+			return exec.Command("gh", "auth", "status").Run() == nil, "Run 'gh auth login'"
+		}},
+		{"git installed", true, func() (bool, string) {
+			return isCommandAvailable("git"), "Install git from https://git-scm.com/"
+		}},
+		{"Blog project initialized", true, func() (bool, string) {
+			if _, err := os.Stat(".gblog/config.json"); err != nil {
+				return false, errNotInitialized().Error()
+			}
+			return true, ""
+		}},
+		{"Config file valid", true, func() (bool, string) {
+			data, err := os.ReadFile(".gblog/config.json")
+			if err != nil {
+				return false, "Create a blog with 'gblog init' first"
+			}
+			config, err := parseConfigStrict(data)
+			if err == nil {
+				err = validateConfig(config)
+			}
+			if err != nil {
+				return false, fmt.Sprintf("%v; fix it with 'gblog config edit'", err)
+			}
+			return true, ""
+		}},
+		{".gitignore matches private posts", false, func() (bool, string) {
+			if _, err := os.Stat(".gblog/config.json"); err != nil {
+				return false, "Create a blog with 'gblog init' first"
+			}
+			entries, err := privatePostEntries()
+			if err != nil {
+				return false, err.Error()
+			}
+			data, _ := os.ReadFile(".gitignore")
+			drift := checkGitignore(string(data), entries)
+			if drift.empty() {
+				return true, ""
+			}
+			return false, fmt.Sprintf("%d missing and %d stale entries; run 'gblog doctor --repair-gitignore'",
+				len(drift.missing), len(drift.stale))
+		}},
+		{"Editor configured", false, func() (bool, string) {
+			return editorCommand() != nil, "Set $EDITOR (e.g. export EDITOR=vim); otherwise 'gblog edit' uses its built-in editor"
+		}},
+	}
+
+	failed := 0
+	for _, check := range checks {
+		ok, hint := check.run()
+		switch {
+		case ok:
+			fmt.Printf("✅ %s\n", check.name)
+		case check.critical:
+			fmt.Printf("❌ %s\n   💡 %s\n", check.name, hint)
+			failed++
+		default:
+			fmt.Printf("⚠️  %s\n   💡 %s\n", check.name, hint)
+		}
+	}
+
+	fmt.Println()
+	if failed > 0 {
+		return withExitCode(ExitCheckFailed, fmt.Errorf("%d check(s) failed", failed))
+	}
+	fmt.Println("Everything looks good!")
+	return nil
+}
+
+// repairGitignore rewrites the gblog-managed section of .gitignore to
+// match the current private posts.
+func repairGitignore() error {
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+
+	entries, err := privatePostEntries()
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(".gitignore")
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read .gitignore: %w", err)
+	}
+
+	repaired := repairGitignoreContent(string(data), entries)
+	if repaired == string(data) {
+		logInfo("✅ .gitignore already matches the private posts")
+		return nil
+	}
+
+	drift := checkGitignore(string(data), entries)
+	if err := os.WriteFile(".gitignore", []byte(repaired), 0644); err != nil {
+		return fmt.Errorf("failed to write .gitignore: %w", err)
+	}
+
+	for _, entry := range drift.missing {
+		logInfo(fmt.Sprintf("  ➕ %s", entry))
+	}
+	for _, entry := range drift.stale {
+		logInfo(fmt.Sprintf("  ➖ %s", entry))
+	}
+	logInfo(fmt.Sprintf("✅ Repaired .gitignore (%d private posts)", len(entries)))
+	return nil
+}
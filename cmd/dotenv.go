@@ -0,0 +1,78 @@
+// cmd/dotenv.go
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// projectEnvPath holds per-project environment variables, such as a
+// GITHUB_TOKEN for this blog only. It is kept out of git.
+const projectEnvPath = ".gblog/.env"
+
+// loadProjectEnv sets the variables from .gblog/.env, overriding the
+// ambient environment for this run. A GITHUB_TOKEN is also exported as
+// GH_TOKEN, which gh prefers, unless the file sets GH_TOKEN itself.
+func loadProjectEnv() error {
+	file, err := os.Open(projectEnvPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", projectEnvPath, err)
+	}
+	defer file.Close()
+
+	// A committed token is a leaked token
+	if isGitRepo() && exec.Command("git", "check-ignore", "-q", projectEnvPath).Run() != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %s is not gitignored; add it to .gitignore so the token isn't committed\n", projectEnvPath)
+	}
+
+	vars, err := parseDotenv(file)
+	if err != nil {
+		return err
+	}
+
+	if token, ok := vars["GITHUB_TOKEN"]; ok {
+		if _, ok := vars["GH_TOKEN"]; !ok {
+			vars["GH_TOKEN"] = token
+		}
+	}
+
+	for key, value := range vars {
+		if err := os.Setenv(key, value); err != nil {
+			return fmt.Errorf("failed to set %s from %s: %w", key, projectEnvPath, err)
+		}
+	}
+	return nil
+}
+
+// parseDotenv reads KEY=VALUE lines. Blank lines and # comments are
+// skipped, an "export " prefix is allowed, and values may be quoted.
+func parseDotenv(file *os.File) (map[string]string, error) {
+	vars := map[string]string{}
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		text = strings.TrimPrefix(text, "export ")
+
+		key, value, ok := strings.Cut(text, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" || strings.ContainsAny(key, " \t") {
+			return nil, fmt.Errorf("%s line %d: expected KEY=VALUE", projectEnvPath, line)
+		}
+
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		vars[key] = value
+	}
+	return vars, scanner.Err()
+}
@@ -2,29 +2,69 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"runtime"
+	"strings"
 
+	"github.com/charmbracelet/x/term"
 	"github.com/spf13/cobra"
 )
 
 var editCmd = &cobra.Command{
 	Use:   "edit <post-id>",
-	Short: "Open a post directory for editing",
-	Long: `Open a post directory in your default file manager or editor.
+	Short: "Open a post for editing",
+	Long: `Open a post for editing.
 
-This will open the post directory so you can edit the markdown file
-and add any auxiliary files before publishing.`,
+The post's markdown file is opened in $VISUAL or $EDITOR when one is
+set. Otherwise, in a terminal, a built-in editor is used (ctrl+s saves,
+esc saves and quits), and you're offered to publish afterwards. Only
+when neither works is the post directory opened in the file manager.
+--tui always uses the built-in editor.
+
+With --wait, the post's markdown file is opened in $EDITOR and gblog
+blocks until the editor exits. Add --publish to publish (or update)
+the gist as soon as you're done.
+
+With --file, a specific file in the post directory (such as a sidecar
+snippet.go) is opened in $EDITOR instead of the markdown file.
+
+With --new-file, that file is created in the post directory first and
+then opened the same way. Source files start with a comment naming the
+post; other files start empty. Existing files are never overwritten.`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		wait, _ := cmd.Flags().GetBool("wait")
+		publish, _ := cmd.Flags().GetBool("publish")
+		file, _ := cmd.Flags().GetString("file")
+		tui, _ := cmd.Flags().GetBool("tui")
+		newFile, _ := cmd.Flags().GetString("new-file")
+		if newFile != "" {
+			return editNewPostFile(args[0], newFile, publish)
+		}
+		if tui {
+			return editPostInTerminal(args[0])
+		}
+		if wait || publish || file != "" {
+			return editPostAndWait(args[0], file, publish)
+		}
 		return editPost(args[0])
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(editCmd)
+	editCmd.Flags().Bool("wait", false, "Open the post in $EDITOR and wait for it to exit")
+	editCmd.Flags().Bool("publish", false, "Publish the post after the editor exits (implies --wait)")
+	editCmd.Flags().Bool("tui", false, "Edit the post with the built-in terminal editor")
+	editCmd.Flags().String("file", "", "Open this file from the post directory in $EDITOR (implies --wait)")
+	editCmd.Flags().String("new-file", "", "Create this file in the post directory and open it in $EDITOR")
+	editCmd.MarkFlagsMutuallyExclusive("new-file", "file", "tui")
 }
 
+// editPost opens a post with the best editor available: $EDITOR, then
+// the built-in terminal editor, then the GUI file manager.
 func editPost(postID string) error {
 	// Find post directory
 	postDir, err := findPostDir(postID)
@@ -32,19 +72,203 @@ func editPost(postID string) error {
 		return err
 	}
 
-	fmt.Printf("📁 Opening post directory: %s\n", postDir)
+	if editorCommand() != nil {
+		return editPostAndWait(postID, "", false)
+	}
+	if term.IsTerminal(os.Stdin.Fd()) && term.IsTerminal(os.Stdout.Fd()) {
+		return editPostInTerminal(postID)
+	}
+
+	logInfo(fmt.Sprintf("📁 Opening post directory: %s", postDir), "post_id", postID, "dir", postDir)
 
 	// Try to open the directory in the file manager
 	if err := openDirectory(postDir); err != nil {
-		fmt.Printf("⚠️  Could not open file manager: %v\n", err)
-		fmt.Printf("📂 Post directory: %s\n", postDir)
-		fmt.Printf("💡 You can manually navigate to this directory to edit your files\n")
+		logWarn(fmt.Sprintf("⚠️  Could not open file manager: %v", err), "post_id", postID)
+		logInfo(fmt.Sprintf("📂 Post directory: %s", postDir), "post_id", postID, "dir", postDir)
+		logInfo("💡 You can manually navigate to this directory to edit your files")
+		return nil
+	}
+
+	logInfo("✅ Opened in file manager", "post_id", postID)
+	logInfo(fmt.Sprintf("💡 Edit your files and run 'gblog publish %s' when ready", postID))
+
+	return nil
+}
+
+// editPostAndWait opens fileName from the post directory, or the primary
+// markdown file when it is empty, in $EDITOR.
+func editPostAndWait(postID, fileName string, publish bool) error {
+	postDir, err := findPostDir(postID)
+	if err != nil {
+		return err
+	}
+
+	var postFile string
+	if fileName != "" {
+		postFile, err = namedPostFile(postDir, fileName)
+	} else {
+		postFile, err = primaryPostFile(postDir)
+	}
+	if err != nil {
+		return err
+	}
+
+	logInfo(fmt.Sprintf("📝 Opening %s in editor...", postFile), "post_id", postID, "file", postFile)
+	if err := openInEditor(postFile); err != nil {
+		return err
+	}
+
+	if !publish {
+		logInfo(fmt.Sprintf("💡 Run 'gblog publish %s' when ready", postID))
+		return nil
+	}
+
+	meta, err := loadPostMeta(postDir)
+	if err != nil {
+		return err
+	}
+
+	return publishPost(postID, publishOptions{update: meta.RemoteID != ""})
+}
+
+// editNewPostFile creates fileName in the post directory, refusing to
+// overwrite an existing file, and opens it in $EDITOR.
+func editNewPostFile(postID, fileName string, publish bool) error {
+	postDir, err := findPostDir(postID)
+	if err != nil {
+		return err
+	}
+
+	meta, err := loadPostMeta(postDir)
+	if err != nil {
+		return err
+	}
+
+	if err := validatePostFilename(fileName); err != nil {
+		return err
+	}
+
+	// Check before creating anything so a missing editor leaves no stray file
+	if editorCommand() == nil {
+		return fmt.Errorf("no editor configured. Set $EDITOR (e.g. export EDITOR=vim)")
+	}
+
+	content := ""
+	if comment := commentPrefix(filepath.Ext(fileName)); comment != "" {
+		content = fmt.Sprintf("%s %s\n", comment, meta.Title)
+	}
+
+	path := filepath.Join(postDir, fileName)
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if os.IsExist(err) {
+		return fmt.Errorf("%s already exists (use --file to edit it)", path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	_, err = file.WriteString(content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	logInfo(fmt.Sprintf("📄 Created %s", path), "post_id", postID, "file", path)
+
+	return editPostAndWait(postID, fileName, publish)
+}
+
+// editPostInTerminal edits the post's markdown with the built-in editor,
+// then offers to publish it if anything was saved.
+func editPostInTerminal(postID string) error {
+	postDir, err := findPostDir(postID)
+	if err != nil {
+		return err
+	}
+
+	postFile, err := primaryPostFile(postDir)
+	if err != nil {
+		return err
+	}
+
+	saved, err := editInTerminal(postFile)
+	if err != nil {
+		return err
+	}
+	if !saved {
+		fmt.Println("No changes saved.")
 		return nil
 	}
+	fmt.Printf("✅ Saved %s\n", postFile)
 
-	fmt.Printf("✅ Opened in file manager\n")
-	fmt.Printf("💡 Edit your files and run 'gblog publish %s' when ready\n", postID)
+	meta, err := loadPostMeta(postDir)
+	if err != nil {
+		return err
+	}
+
+	question := "Publish it now?"
+	if meta.RemoteID != "" {
+		question = "Update the gist now?"
+	}
+	ok, err := confirm(question)
+	if err != nil || !ok {
+		fmt.Printf("💡 Run 'gblog publish %s' when ready\n", postID)
+		return nil
+	}
+
+	return publishPost(postID, publishOptions{update: meta.RemoteID != ""})
+}
+
+// namedPostFile returns the path of name inside postDir. If there is no
+// such file, the error lists the files that do exist.
+func namedPostFile(postDir, name string) (string, error) {
+	path := filepath.Join(postDir, name)
+	if rel, err := filepath.Rel(postDir, path); err != nil || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("%s is outside the post directory", name)
+	}
+
+	if info, err := os.Stat(path); err == nil && !info.IsDir() {
+		return path, nil
+	}
+
+	entries, err := os.ReadDir(postDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to read post directory: %w", err)
+	}
+	var files []string
+	for _, entry := range entries {
+		if !entry.IsDir() && entry.Name() != ".meta.json" {
+			files = append(files, entry.Name())
+		}
+	}
+
+	return "", fmt.Errorf("file %s not found in %s (available: %s)", name, postDir, strings.Join(files, ", "))
+}
+
+// editorCommand returns the user's preferred editor from $VISUAL or $EDITOR.
+func editorCommand() []string {
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if fields := strings.Fields(os.Getenv(env)); len(fields) > 0 {
+			return fields
+		}
+	}
+	return nil
+}
 
+// openInEditor opens path in the user's editor and blocks until it exits.
+func openInEditor(path string) error {
+	editor := editorCommand()
+	if editor == nil {
+		return fmt.Errorf("no editor configured. Set $EDITOR (e.g. export EDITOR=vim)")
+	}
+
+	cmd := exec.Command(editor[0], append(editor[1:], path)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("editor exited with error: %w", err)
+	}
 	return nil
 }
 
@@ -0,0 +1,44 @@
+// cmd/exitcode.go
+package cmd
+
+import "errors"
+
+// Exit codes gblog returns, so scripts can tell failures apart without
+// matching on error messages.
+const (
+	ExitOK             = 0 // success
+	ExitError          = 1 // any other error
+	ExitNotInitialized = 2 // no gblog project in the working directory
+	ExitPostNotFound   = 3 // no post with the given ID
+	ExitAuthFailed     = 4 // gh is missing or not authenticated
+	ExitNothingToDo    = 5 // there was nothing for the command to act on
+	ExitCheckFailed    = 6 // linkcheck, lint --strict, or doctor found problems
+)
+
+// exitError attaches an exit code to an error.
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string { return e.err.Error() }
+
+func (e *exitError) Unwrap() error { return e.err }
+
+// withExitCode wraps err so that gblog exits with code when it is
+// returned from a command.
+func withExitCode(code int, err error) error {
+	return &exitError{code: code, err: err}
+}
+
+// ExitCode returns the exit code for an error returned by Execute.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	var exitErr *exitError
+	if errors.As(err, &exitErr) {
+		return exitErr.code
+	}
+	return ExitError
+}
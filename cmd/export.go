@@ -2,12 +2,14 @@ package cmd
 
 import (
 	"archive/zip"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -19,65 +21,194 @@ var exportCmd = &cobra.Command{
 	Long: `Export all blog posts (public and private) to a zip file.
 
 The exported archive will contain all posts organized by date,
-including all markdown files and auxiliary files.`,
+including all markdown files and auxiliary files. With --flat, post
+directories go straight under posts/ instead of posts/2006/01/02/.
+
+By default the export stops at the first post that can't be read. With
+--keep-going, failing posts are reported, skipped, and listed under
+"skipped" in export-metadata.json.
+
+With --manifest-only, only the export-metadata.json catalog is written
+(to stdout unless an output file is given), extended with each post's
+tags, word count, and files.
+
+With --split-by-tag, one archive is written per tag, named after the
+output file (gblog-export-go.zip, gblog-export-k8s.zip, ...). Posts with
+several tags appear in each of their archives.
+
+With --checksums, the archive also holds checksums.sha256 (in sha256sum
+format) listing every file in it. Check an archive with 'gblog verify'.
+
+With --encrypt, you're asked for a passphrase and the archive is
+encrypted with AES-256-GCM (key derived with scrypt) and written as
+gblog-export.zip.enc. Set GBLOG_PASSPHRASE to skip the prompt. Restore
+it with 'gblog import --decrypt'.`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		manifestOnly, _ := cmd.Flags().GetBool("manifest-only")
+		encrypt, _ := cmd.Flags().GetBool("encrypt")
 		outputFile := "gblog-export.zip"
+		if encrypt {
+			outputFile = "gblog-export.zip.enc"
+		}
+		if manifestOnly {
+			outputFile = "-"
+		}
 		if len(args) > 0 {
 			outputFile = args[0]
 		}
-		return exportPosts(outputFile)
+		if output, _ := cmd.Flags().GetString("output"); output != "" {
+			outputFile = output
+		}
+		dates, err := readDateRangeFlags(cmd)
+		if err != nil {
+			return err
+		}
+		keepGoing, _ := cmd.Flags().GetBool("keep-going")
+		checksums, _ := cmd.Flags().GetBool("checksums")
+		flat, _ := cmd.Flags().GetBool("flat")
+		splitByTag, _ := cmd.Flags().GetBool("split-by-tag")
+		if splitByTag && manifestOnly {
+			return fmt.Errorf("--split-by-tag cannot be combined with --manifest-only")
+		}
+		if checksums && manifestOnly {
+			return fmt.Errorf("--checksums cannot be combined with --manifest-only")
+		}
+		if encrypt && (manifestOnly || splitByTag) {
+			return fmt.Errorf("--encrypt cannot be combined with --manifest-only or --split-by-tag")
+		}
+		opts := exportOptions{
+			window:       dates,
+			keepGoing:    keepGoing,
+			manifestOnly: manifestOnly,
+			splitByTag:   splitByTag,
+			checksums:    checksums,
+			flat:         flat,
+		}
+		if encrypt {
+			return exportEncrypted(outputFile, opts)
+		}
+		_, err = exportPosts(outputFile, opts)
+		return err
 	},
 }
 
+// exportEncrypted exports to a temporary zip and encrypts it into
+// outputFile, adding a .enc extension if it is missing.
+func exportEncrypted(outputFile string, opts exportOptions) error {
+	if !strings.HasSuffix(outputFile, ".enc") {
+		outputFile += ".enc"
+	}
+
+	// Ask before doing any work so a typo doesn't cost a full export
+	passphrase, err := readPassphrase(true)
+	if err != nil {
+		return err
+	}
+
+	tmpDir, err := os.MkdirTemp("", "gblog-export-")
+	if err != nil {
+		return fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	archive, err := exportPosts(filepath.Join(tmpDir, "gblog-export.zip"), opts)
+	if err != nil {
+		return err
+	}
+
+	if err := encryptFile(archive, outputFile, passphrase); err != nil {
+		return err
+	}
+
+	logInfo(fmt.Sprintf("🔒 Encrypted archive: %s", outputFile), "archive", outputFile)
+	return nil
+}
+
+type exportOptions struct {
+	window       dateRange
+	keepGoing    bool // skip posts that fail instead of aborting
+	manifestOnly bool // write only the metadata catalog, no zip
+	splitByTag   bool // write one archive per tag
+	checksums    bool // add a checksums.sha256 manifest to the archive
+	flat         bool // write posts/<dir>/ instead of posts/2006/01/02/<dir>/
+}
+
+// exportManifest is the catalog written to export-metadata.json.
+type exportManifest struct {
+	ExportedAt time.Time            `json:"exported_at"`
+	TotalPosts int                  `json:"total_posts"`
+	Posts      []exportManifestPost `json:"posts"`
+	Skipped    []skippedPost        `json:"skipped,omitempty"`
+}
+
+// exportManifestPost describes one exported post. Tags, word count, and
+// files are only filled in for --manifest-only.
+type exportManifestPost struct {
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+	Public    bool      `json:"public"`
+	CreatedAt time.Time `json:"created_at"`
+	Backend   string    `json:"backend,omitempty"`
+	RemoteURL string    `json:"remote_url,omitempty"`
+	// GistURL repeats RemoteURL under its old name for existing readers
+	GistURL   string   `json:"gist_url,omitempty"`
+	Tags      []string `json:"tags,omitempty"`
+	WordCount int      `json:"word_count,omitempty"`
+	Files     []string `json:"files,omitempty"`
+}
+
+// skippedPost records a post left out of an export and why.
+type skippedPost struct {
+	ID    string `json:"id"`
+	Dir   string `json:"dir"`
+	Error string `json:"error"`
+}
+
+// postFileData is a file read from a post directory, ready for the zip.
+type postFileData struct {
+	relPath string
+	data    []byte
+}
+
 func init() {
 	rootCmd.AddCommand(exportCmd)
+	addDateRangeFlags(exportCmd)
+	exportCmd.Flags().Bool("keep-going", false, "Skip posts that fail to export instead of aborting")
+	exportCmd.Flags().Bool("manifest-only", false, "Write only the metadata catalog as JSON, without a zip")
+	exportCmd.Flags().Bool("split-by-tag", false, "Write one archive per tag (e.g. gblog-export-go.zip)")
+	exportCmd.Flags().Bool("checksums", false, "Add a checksums.sha256 manifest for 'gblog verify'")
+	exportCmd.Flags().Bool("flat", false, "Put post directories directly under posts/, without date directories")
+	exportCmd.Flags().Bool("encrypt", false, "Encrypt the archive with a passphrase (writes gblog-export.zip.enc)")
+	exportCmd.Flags().StringP("output", "o", "", "Output file ('-' for stdout with --manifest-only)")
 }
 
-func exportPosts(outputFile string) error {
+// exportPosts writes the posts to a zip archive at outputFile and returns
+// its path.
+func exportPosts(outputFile string, opts exportOptions) (string, error) {
 	// Check if gblog is initialized
 	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
-		return fmt.Errorf("gblog not initialized. Run 'gblog init' first")
+		return "", errNotInitialized()
 	}
 
 	// Read posts directory
 	postsDir := "posts"
 	if _, err := os.Stat(postsDir); os.IsNotExist(err) {
-		return fmt.Errorf("no posts directory found")
+		return "", fmt.Errorf("no posts directory found")
 	}
 
-	entries, err := os.ReadDir(postsDir)
+	posts, err := loadPosts()
 	if err != nil {
-		return fmt.Errorf("failed to read posts directory: %w", err)
+		return "", err
 	}
 
-	var posts []PostInfo
-	for _, entry := range entries {
-		if !entry.IsDir() {
-			continue
-		}
-
-		metaPath := filepath.Join(postsDir, entry.Name(), ".meta.json")
-		metaData, err := os.ReadFile(metaPath)
-		if err != nil {
-			fmt.Printf("Warning: could not read metadata for %s: %v\n", entry.Name(), err)
-			continue
-		}
-
-		var meta PostMeta
-		if err := json.Unmarshal(metaData, &meta); err != nil {
-			fmt.Printf("Warning: could not parse metadata for %s: %v\n", entry.Name(), err)
-			continue
-		}
-
-		posts = append(posts, PostInfo{
-			Meta: meta,
-			Dir:  entry.Name(),
-		})
+	posts, err = filterPosts(posts, postFilter{dates: opts.window})
+	if err != nil {
+		return "", err
 	}
 
 	if len(posts) == 0 {
-		return fmt.Errorf("no posts found to export")
+		return "", withExitCode(ExitNothingToDo, fmt.Errorf("no posts found to export"))
 	}
 
 	// Sort posts by creation date
@@ -85,127 +216,139 @@ func exportPosts(outputFile string) error {
 		return posts[i].Meta.CreatedAt.Before(posts[j].Meta.CreatedAt)
 	})
 
+	if opts.manifestOnly {
+		return writeExportManifest(outputFile, posts, opts.keepGoing)
+	}
+	if opts.splitByTag {
+		return outputFile, exportByTag(outputFile, posts, opts)
+	}
+
+	return writeExportZip(outputFile, posts, opts)
+}
+
+// writeExportZip writes posts, sorted by creation date, to a zip archive
+// at outputFile and returns its path.
+func writeExportZip(outputFile string, posts []PostInfo, opts exportOptions) (string, error) {
+	postsDir := "posts"
+
 	// Create zip file
 	zipFile, err := os.Create(outputFile)
 	if err != nil {
-		return fmt.Errorf("failed to create zip file: %w", err)
+		return "", fmt.Errorf("failed to create zip file: %w", err)
 	}
 	defer zipFile.Close()
 
 	zipWriter := zip.NewWriter(zipFile)
 	defer zipWriter.Close()
 
-	fmt.Printf("📦 Exporting %d posts to %s...\n", len(posts), outputFile)
+	config, err := loadConfig()
+	if err != nil {
+		return "", err
+	}
+	dates := newDateFormatter(config, false)
+
+	logInfo(fmt.Sprintf("📦 Exporting %d posts to %s...", len(posts), outputFile), "archive", outputFile, "posts", len(posts))
 
-	// Add each post to the zip
+	// Add each post to the zip, hashing the contents on the way in
+	var checksums strings.Builder
+	var exported []PostInfo
+	var skipped []skippedPost
+	zipDirs := map[string]string{}
 	for _, post := range posts {
 		postPath := filepath.Join(postsDir, post.Dir)
 
 		// Create directory structure based on creation date
-		createdDate := post.Meta.CreatedAt.Format("2006/01/02")
-		zipDirPath := filepath.Join("posts", createdDate, post.Dir)
-
-		fmt.Printf("  📁 Adding %s (%s)...\n", post.Meta.Title, post.Meta.ID)
-
-		// Add all files in the post directory
-		err := filepath.Walk(postPath, func(filePath string, info os.FileInfo, err error) error {
-			if err != nil {
-				return err
-			}
+		zipDirPath := filepath.Join("posts", filepath.Base(post.Dir))
+		if !opts.flat {
+			createdDate := dates.In(post.Meta.CreatedAt).Format("2006/01/02")
+			zipDirPath = filepath.Join("posts", createdDate, filepath.Base(post.Dir))
+		}
+		if other, ok := zipDirs[zipDirPath]; ok {
+			return "", fmt.Errorf("posts %s and %s would both be exported to %s", other, post.Meta.ID, zipDirPath)
+		}
+		zipDirs[zipDirPath] = post.Meta.ID
 
-			if info.IsDir() {
-				return nil
-			}
+		logInfo(fmt.Sprintf("  📁 Adding %s (%s)...", post.Meta.Title, post.Meta.ID), "post_id", post.Meta.ID)
 
-			// Calculate relative path within the post directory
-			relPath, err := filepath.Rel(postPath, filePath)
-			if err != nil {
-				return err
+		// Read the whole post first so a failure never leaves it half-written
+		files, err := readPostFiles(postPath)
+		if err != nil {
+			if !opts.keepGoing {
+				return "", fmt.Errorf("failed to add post %s to zip: %w", post.Meta.ID, err)
 			}
+			fmt.Fprintf(os.Stderr, "Warning: skipping post %s: %v\n", post.Meta.ID, err)
+			skipped = append(skipped, skippedPost{ID: post.Meta.ID, Dir: post.Dir, Error: err.Error()})
+			continue
+		}
 
+		for _, file := range files {
 			// Create the file path in the zip
-			zipFilePath := filepath.Join(zipDirPath, relPath)
-			zipFilePath = filepath.ToSlash(zipFilePath) // Ensure forward slashes in zip
+			zipFilePath := filepath.ToSlash(filepath.Join(zipDirPath, file.relPath)) // Ensure forward slashes in zip
 
-			// Create the file in the zip
 			zipFileWriter, err := zipWriter.Create(zipFilePath)
 			if err != nil {
-				return fmt.Errorf("failed to create file in zip: %w", err)
+				return "", fmt.Errorf("failed to create file in zip: %w", err)
 			}
-
-			// Copy file contents
-			fileReader, err := os.Open(filePath)
-			if err != nil {
-				return fmt.Errorf("failed to open file: %w", err)
+			if _, err := zipFileWriter.Write(file.data); err != nil {
+				return "", fmt.Errorf("failed to copy file contents: %w", err)
 			}
-			defer fileReader.Close()
-
-			_, err = io.Copy(zipFileWriter, fileReader)
-			if err != nil {
-				return fmt.Errorf("failed to copy file contents: %w", err)
+			if opts.checksums {
+				fmt.Fprintf(&checksums, "%x  %s\n", sha256.Sum256(file.data), zipFilePath)
 			}
-
-			return nil
-		})
-
-		if err != nil {
-			return fmt.Errorf("failed to add post %s to zip: %w", post.Meta.ID, err)
 		}
+
+		exported = append(exported, post)
 	}
+	posts = exported
 
 	// Add export metadata
-	exportMeta := struct {
-		ExportedAt time.Time `json:"exported_at"`
-		TotalPosts int       `json:"total_posts"`
-		Posts      []struct {
-			ID        string    `json:"id"`
-			Title     string    `json:"title"`
-			Public    bool      `json:"public"`
-			CreatedAt time.Time `json:"created_at"`
-			GistURL   string    `json:"gist_url,omitempty"`
-		} `json:"posts"`
-	}{
+	exportMeta := exportManifest{
 		ExportedAt: time.Now(),
 		TotalPosts: len(posts),
+		Skipped:    skipped,
 	}
-
 	for _, post := range posts {
-		exportMeta.Posts = append(exportMeta.Posts, struct {
-			ID        string    `json:"id"`
-			Title     string    `json:"title"`
-			Public    bool      `json:"public"`
-			CreatedAt time.Time `json:"created_at"`
-			GistURL   string    `json:"gist_url,omitempty"`
-		}{
-			ID:        post.Meta.ID,
-			Title:     post.Meta.Title,
-			Public:    post.Meta.Public,
-			CreatedAt: post.Meta.CreatedAt,
-			GistURL:   post.Meta.GistURL,
-		})
+		exportMeta.Posts = append(exportMeta.Posts, newExportManifestPost(post))
 	}
 
 	// Add export metadata file
 	metaWriter, err := zipWriter.Create("export-metadata.json")
 	if err != nil {
-		return fmt.Errorf("failed to create metadata file in zip: %w", err)
+		return "", fmt.Errorf("failed to create metadata file in zip: %w", err)
 	}
 
-	encoder := json.NewEncoder(metaWriter)
-	encoder.SetIndent("", "  ")
-	if err := encoder.Encode(exportMeta); err != nil {
-		return fmt.Errorf("failed to write export metadata: %w", err)
+	metaData, err := json.MarshalIndent(exportMeta, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to write export metadata: %w", err)
+	}
+	metaData = append(metaData, '\n')
+	if _, err := metaWriter.Write(metaData); err != nil {
+		return "", fmt.Errorf("failed to write export metadata: %w", err)
 	}
 
-	fmt.Printf("✅ Export completed successfully!\n")
-	fmt.Printf("📦 Archive: %s\n", outputFile)
-	fmt.Printf("📊 Total posts: %d\n", len(posts))
+	if opts.checksums {
+		fmt.Fprintf(&checksums, "%x  %s\n", sha256.Sum256(metaData), "export-metadata.json")
+		sumWriter, err := zipWriter.Create(checksumsFileName)
+		if err != nil {
+			return "", fmt.Errorf("failed to create %s in zip: %w", checksumsFileName, err)
+		}
+		if _, err := io.WriteString(sumWriter, checksums.String()); err != nil {
+			return "", fmt.Errorf("failed to write checksums: %w", err)
+		}
+	}
+
+	logInfo("✅ Export completed successfully!", "archive", outputFile)
+	logInfo(fmt.Sprintf("📦 Archive: %s", outputFile), "archive", outputFile)
+	logInfo(fmt.Sprintf("📊 Total posts: %d", len(posts)), "posts", len(posts))
+	if len(skipped) > 0 {
+		logWarn(fmt.Sprintf("⚠️  Skipped: %d (see export-metadata.json)", len(skipped)), "skipped", len(skipped))
+	}
 
 	// Count stats
 	published := 0
 	private := 0
 	for _, post := range posts {
-		if post.Meta.GistID != "" {
+		if post.Meta.RemoteID != "" {
 			published++
 		}
 		if !post.Meta.Public {
@@ -213,7 +356,158 @@ func exportPosts(outputFile string) error {
 		}
 	}
 
-	fmt.Printf("📈 Published: %d, Drafts: %d, Private: %d\n", published, len(posts)-published, private)
+	logInfo(fmt.Sprintf("📈 Published: %d, Drafts: %d, Private: %d", published, len(posts)-published, private),
+		"published", published, "drafts", len(posts)-published, "private", private)
+
+	return outputFile, nil
+}
+
+// exportByTag writes one archive per tag next to outputFile, named
+// <base>-<tag>.zip. Posts with several tags go into each archive. Tags
+// whose names slugify alike (C++ and c) get -2, -3, ... suffixes.
+func exportByTag(outputFile string, posts []PostInfo, opts exportOptions) error {
+	byTag := map[string][]PostInfo{}
+	for _, post := range posts {
+		for _, tag := range normalizeTags(post.Meta.Tags) {
+			byTag[tag] = append(byTag[tag], post)
+		}
+	}
+	if len(byTag) == 0 {
+		return withExitCode(ExitNothingToDo, fmt.Errorf("no tagged posts found to export"))
+	}
+
+	tags := make([]string, 0, len(byTag))
+	for tag := range byTag {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	base := strings.TrimSuffix(outputFile, filepath.Ext(outputFile))
+	archives := make(map[string]string, len(tags))
+	taken := map[string]bool{}
+	for _, tag := range tags {
+		slug := slugify(tag)
+		if slug == "" {
+			slug = "tag"
+		}
+		name := slug
+		for n := 2; taken[name]; n++ {
+			name = fmt.Sprintf("%s-%d", slug, n)
+		}
+		taken[name] = true
+		archive := fmt.Sprintf("%s-%s.zip", base, name)
+		if _, err := writeExportZip(archive, byTag[tag], opts); err != nil {
+			return fmt.Errorf("failed to export tag %s: %w", tag, err)
+		}
+		archives[tag] = archive
+		if logger == nil {
+			fmt.Println()
+		}
+	}
+
+	if logger != nil {
+		for _, tag := range tags {
+			logger.Info("exported tag", "tag", tag, "archive", archives[tag], "posts", len(byTag[tag]))
+		}
+		return nil
+	}
+
+	fmt.Println("🏷️  Archives by tag:")
+	for _, tag := range tags {
+		fmt.Printf("  %-20s %-40s %d posts\n", tag, archives[tag], len(byTag[tag]))
+	}
 
 	return nil
 }
+
+// readPostFiles reads every file in a post directory, returning paths
+// relative to it.
+func readPostFiles(postPath string) ([]postFileData, error) {
+	var files []postFileData
+	err := filepath.Walk(postPath, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		// Calculate relative path within the post directory
+		relPath, err := filepath.Rel(postPath, filePath)
+		if err != nil {
+			return err
+		}
+
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return fmt.Errorf("failed to read file: %w", err)
+		}
+
+		files = append(files, postFileData{relPath: relPath, data: data})
+		return nil
+	})
+	return files, err
+}
+
+func newExportManifestPost(post PostInfo) exportManifestPost {
+	return exportManifestPost{
+		ID:        post.Meta.ID,
+		Title:     post.Meta.Title,
+		Public:    post.Meta.Public,
+		CreatedAt: post.Meta.CreatedAt,
+		Backend:   post.Meta.Backend,
+		RemoteURL: post.Meta.RemoteURL,
+		GistURL:   post.Meta.RemoteURL,
+	}
+}
+
+// writeExportManifest writes the extended export catalog to outputFile,
+// or stdout for "-", and returns outputFile.
+func writeExportManifest(outputFile string, posts []PostInfo, keepGoing bool) (string, error) {
+	manifest := exportManifest{ExportedAt: time.Now()}
+
+	for _, post := range posts {
+		files, err := readPostFiles(filepath.Join("posts", post.Dir))
+		if err != nil {
+			if !keepGoing {
+				return "", fmt.Errorf("failed to read post %s: %w", post.Meta.ID, err)
+			}
+			fmt.Fprintf(os.Stderr, "Warning: skipping post %s: %v\n", post.Meta.ID, err)
+			manifest.Skipped = append(manifest.Skipped, skippedPost{ID: post.Meta.ID, Dir: post.Dir, Error: err.Error()})
+			continue
+		}
+
+		entry := newExportManifestPost(post)
+		entry.Tags = post.Meta.Tags
+		for _, file := range files {
+			if filepath.Base(file.relPath) == ".meta.json" {
+				continue
+			}
+			entry.Files = append(entry.Files, filepath.ToSlash(file.relPath))
+			if strings.ToLower(filepath.Ext(file.relPath)) == ".md" {
+				entry.WordCount += len(strings.Fields(string(file.data)))
+			}
+		}
+		manifest.Posts = append(manifest.Posts, entry)
+	}
+	manifest.TotalPosts = len(manifest.Posts)
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	data = append(data, '\n')
+
+	if outputFile == "-" {
+		_, err = os.Stdout.Write(data)
+		return outputFile, err
+	}
+
+	if err := os.WriteFile(outputFile, data, 0644); err != nil {
+		return "", fmt.Errorf("failed to write manifest: %w", err)
+	}
+	logInfo(fmt.Sprintf("✅ Wrote manifest for %d posts to %s", manifest.TotalPosts, outputFile))
+
+	return outputFile, nil
+}
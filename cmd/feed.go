@@ -0,0 +1,218 @@
+// cmd/feed.go
+package cmd
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var feedCmd = &cobra.Command{
+	Use:   "feed",
+	Short: "Generate an RSS feed of published posts",
+	Long: `Generate an RSS 2.0 feed of the blog's published public posts, linking
+to their gists, newest first.
+
+Private posts are never included. With --include-drafts, unpublished
+public posts are added for previews: their titles are prefixed with
+[DRAFT], they carry a "draft" category, and they have no link or
+pubDate.
+
+Items are credited to github_user. Posts in a series say which part
+they are and link the previous and next published parts. The channel
+links to github_user's gists, or to the blog repository when
+github_user isn't set.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		output, _ := cmd.Flags().GetString("output")
+		includeDrafts, _ := cmd.Flags().GetBool("include-drafts")
+		return writeFeed(output, includeDrafts)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(feedCmd)
+	feedCmd.Flags().StringP("output", "o", "-", "Output file ('-' for stdout)")
+	feedCmd.Flags().Bool("include-drafts", false, "Include unpublished public posts, marked as drafts")
+}
+
+// dcNamespace is the Dublin Core namespace used for dc:creator, since
+// RSS's own author elements require an email address.
+const dcNamespace = "http://purl.org/dc/elements/1.1/"
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	DC      string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title       string    `xml:"title"`
+	Link        string    `xml:"link"`
+	Description string    `xml:"description"`
+	Creator     string    `xml:"dc:creator,omitempty"`
+	Items       []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link,omitempty"`
+	Description string   `xml:"description,omitempty"`
+	Creator     string   `xml:"dc:creator,omitempty"`
+	Categories  []string `xml:"category"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate,omitempty"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+func writeFeed(output string, includeDrafts bool) error {
+	// Check if gblog is initialized
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	posts, err := loadPosts()
+	if err != nil {
+		return err
+	}
+
+	var items []PostInfo
+	for _, post := range posts {
+		if !post.Meta.Public {
+			continue
+		}
+		if post.Meta.RemoteID == "" && !includeDrafts {
+			continue
+		}
+		items = append(items, post)
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return feedDate(items[i].Meta).After(feedDate(items[j].Meta))
+	})
+
+	link, err := feedChannelLink(config)
+	if err != nil {
+		return err
+	}
+	channel := rssChannel{
+		Title:       config.RepoName,
+		Link:        link,
+		Description: fmt.Sprintf("Posts from %s", config.RepoName),
+		Creator:     config.GitHubUser,
+	}
+
+	for _, post := range items {
+		item := rssItem{
+			Title:       post.Meta.Title,
+			Description: post.Meta.Description,
+			Creator:     config.GitHubUser,
+			Categories:  post.Meta.Tags,
+		}
+		if post.Meta.Series != "" {
+			item.Description = strings.TrimSpace(item.Description + "\n\n" + feedSeriesLinks(posts, post))
+		}
+		if post.Meta.RemoteID == "" {
+			item.Title = "[DRAFT] " + item.Title
+			item.Categories = append([]string{"draft"}, item.Categories...)
+			item.GUID = rssGUID{Value: "draft-" + post.Meta.ID}
+		} else {
+			item.Link = post.Meta.RemoteURL
+			item.GUID = rssGUID{IsPermaLink: true, Value: post.Meta.RemoteURL}
+			item.PubDate = feedDate(post.Meta).Format(time.RFC1123Z)
+		}
+		channel.Items = append(channel.Items, item)
+	}
+
+	data, err := xml.MarshalIndent(rssFeed{Version: "2.0", DC: dcNamespace, Channel: channel}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode feed: %w", err)
+	}
+	data = append([]byte(xml.Header), append(data, '\n')...)
+
+	if output == "-" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+
+	if err := os.WriteFile(output, data, 0644); err != nil {
+		return fmt.Errorf("failed to write feed: %w", err)
+	}
+	logInfo(fmt.Sprintf("✅ Wrote %d posts to %s", len(channel.Items), output))
+
+	return nil
+}
+
+// feedDate is when a post appeared: its publish time, or its creation
+// time for drafts and posts published before that was recorded.
+func feedDate(meta PostMeta) time.Time {
+	if meta.PublishedAt != nil {
+		return *meta.PublishedAt
+	}
+	return meta.CreatedAt
+}
+
+// feedChannelLink returns the channel's required link: the user's gist
+// page, or the web address of the blog repository's origin remote.
+func feedChannelLink(config Config) (string, error) {
+	if config.GitHubUser != "" {
+		return fmt.Sprintf("https://gist.github.com/%s", config.GitHubUser), nil
+	}
+	if output, err := exec.Command("git", "remote", "get-url", "origin").Output(); err == nil {
+		if webURL, err := repoWebURL(strings.TrimSpace(string(output))); err == nil {
+			return webURL, nil
+		}
+	}
+	return "", fmt.Errorf("the feed needs a link: set github_user with 'gblog config set github_user <login>' or add an origin remote")
+}
+
+// feedSeriesLinks describes a post's place in its series, with links to
+// the previous and next parts that are published publicly.
+func feedSeriesLinks(posts []PostInfo, post PostInfo) string {
+	parts := seriesPosts(posts, post.Meta.Series)
+
+	var lines []string
+	for i, part := range parts {
+		if part.Meta.ID != post.Meta.ID {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("Part %d of %d in the series %q.", i+1, len(parts), post.Meta.Series))
+		if prev := feedSeriesNeighbor(parts[:i], -1); prev != nil {
+			lines = append(lines, fmt.Sprintf("Previous: %s (%s)", prev.Meta.Title, prev.Meta.RemoteURL))
+		}
+		if next := feedSeriesNeighbor(parts[i+1:], 1); next != nil {
+			lines = append(lines, fmt.Sprintf("Next: %s (%s)", next.Meta.Title, next.Meta.RemoteURL))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// feedSeriesNeighbor returns the closest linkable part in parts, searching
+// backwards from the end when dir is -1 and forwards otherwise.
+func feedSeriesNeighbor(parts []PostInfo, dir int) *PostInfo {
+	for n := range parts {
+		i := n
+		if dir < 0 {
+			i = len(parts) - 1 - n
+		}
+		if parts[i].Meta.Public && parts[i].Meta.RemoteURL != "" {
+			return &parts[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+// cmd/gc.go
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// gcTempFileAge is how old a leftover temp file must be before gc removes
+// it, so a gblog running alongside never loses the file it is writing.
+const gcTempFileAge = time.Hour
+
+var gcCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Clean up caches and stray files",
+	Long: `Remove files the project no longer needs:
+
+  - expired entries in .gblog/cache (comments and stars), and cached
+    comments for gists no post tracks anymore
+  - editor swap and backup files in post directories (*~, .#*, *.swp,
+    *.swo, #*#)
+  - temp files left behind by an interrupted gblog
+
+The space reclaimed is reported at the end. Use --dry-run to only list
+what would be removed.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		return collectGarbage(dryRun)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(gcCmd)
+	gcCmd.Flags().BoolP("dry-run", "n", false, "List what would be removed without removing it")
+}
+
+// gcResult tallies what a gc run removed (or would remove).
+type gcResult struct {
+	dryRun bool
+	files  int
+	bytes  int64
+}
+
+// remove deletes path, or only reports it on a dry run.
+func (r *gcResult) remove(path, reason string) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return
+	}
+	if !r.dryRun {
+		if err := os.Remove(path); err != nil {
+			logWarnStderr(fmt.Sprintf("Warning: could not remove %s: %v", path, err), "path", path)
+			return
+		}
+	}
+	logInfo(fmt.Sprintf("  🗑️  %s (%s, %s)", path, reason, formatSize(info.Size())), "path", path, "reason", reason, "bytes", info.Size())
+	r.files++
+	r.bytes += info.Size()
+}
+
+func collectGarbage(dryRun bool) error {
+	// Check if gblog is initialized
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+
+	posts, err := loadPosts()
+	if err != nil {
+		return err
+	}
+
+	result := &gcResult{dryRun: dryRun}
+
+	if err := gcCommentsCache(result, posts); err != nil {
+		return err
+	}
+	if err := gcStarCache(result); err != nil {
+		return err
+	}
+	if err := gcTempFiles(result, ".gblog"); err != nil {
+		return err
+	}
+	for _, post := range posts {
+		if err := gcPostDir(result, filepath.Join("posts", post.Dir)); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case result.files == 0:
+		logInfo("✨ Nothing to clean up")
+	case dryRun:
+		logInfo(fmt.Sprintf("Would remove %d file(s), reclaiming %s", result.files, formatSize(result.bytes)), "files", result.files, "bytes", result.bytes)
+	default:
+		logInfo(fmt.Sprintf("✅ Removed %d file(s), reclaimed %s", result.files, formatSize(result.bytes)), "files", result.files, "bytes", result.bytes)
+	}
+	return nil
+}
+
+// gcCommentsCache removes cached comments that have expired or belong to
+// gists no post tracks.
+func gcCommentsCache(result *gcResult, posts []PostInfo) error {
+	entries, err := os.ReadDir(commentsCacheDir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", commentsCacheDir, err)
+	}
+
+	tracked := map[string]bool{}
+	for _, post := range posts {
+		if post.Meta.RemoteID != "" {
+			tracked[post.Meta.RemoteID] = true
+		}
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		path := filepath.Join(commentsCacheDir, entry.Name())
+
+		if !tracked[strings.TrimSuffix(entry.Name(), ".json")] {
+			result.remove(path, "untracked gist")
+			continue
+		}
+
+		var cache commentsCache
+		data, err := os.ReadFile(path)
+		if err == nil {
+			err = json.Unmarshal(data, &cache)
+		}
+		if err != nil {
+			result.remove(path, "unreadable")
+		} else if time.Since(cache.FetchedAt) > commentsCacheTTL {
+			result.remove(path, "expired")
+		}
+	}
+	return nil
+}
+
+// gcStarCache drops expired star checks, removing the cache file once
+// nothing in it is current.
+func gcStarCache(result *gcResult) error {
+	if _, err := os.Stat(starCachePath); os.IsNotExist(err) {
+		return nil
+	}
+
+	cache := loadStarCache()
+	fresh := map[string]starStatus{}
+	for gistID, status := range cache {
+		if time.Since(status.CheckedAt) < starCacheTTL {
+			fresh[gistID] = status
+		}
+	}
+
+	if len(fresh) == 0 {
+		result.remove(starCachePath, "expired")
+		return nil
+	}
+	if expired := len(cache) - len(fresh); expired > 0 {
+		logInfo(fmt.Sprintf("  🧹 %s: %d expired entries", starCachePath, expired), "path", starCachePath, "expired", expired)
+		if !result.dryRun {
+			saveStarCache(fresh)
+		}
+	}
+	return nil
+}
+
+// gcTempFiles removes stale temp files from interrupted atomic writes and
+// config edits in dir.
+func gcTempFiles(result *gcResult, dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !(strings.Contains(name, ".tmp-") || strings.HasPrefix(name, "config-edit-")) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || time.Since(info.ModTime()) < gcTempFileAge {
+			continue
+		}
+		result.remove(filepath.Join(dir, name), "leftover temp file")
+	}
+	return nil
+}
+
+// gcPostDir removes editor swap and backup files, and stale temp files,
+// from a post directory.
+func gcPostDir(result *gcResult, postDir string) error {
+	if err := gcTempFiles(result, postDir); err != nil {
+		return err
+	}
+
+	return filepath.WalkDir(postDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && isEditorJunk(d.Name()) {
+			result.remove(path, "editor file")
+		}
+		return nil
+	})
+}
+
+// isEditorJunk reports whether name looks like an editor swap, lock, or
+// backup file.
+func isEditorJunk(name string) bool {
+	switch {
+	case strings.HasSuffix(name, "~"),
+		strings.HasPrefix(name, ".#"),
+		strings.HasPrefix(name, "#") && strings.HasSuffix(name, "#"),
+		strings.HasSuffix(name, ".swp"),
+		strings.HasSuffix(name, ".swo"):
+		return true
+	}
+	return false
+}
+
+// formatSize renders a byte count for people, e.g. 1.5 KB.
+func formatSize(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
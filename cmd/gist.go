@@ -0,0 +1,208 @@
+// cmd/gist.go
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var gistCmd = &cobra.Command{
+	Use:   "gist",
+	Short: "Work with a post's gist on GitHub",
+	Long:  `Commands that act on the GitHub gists behind published posts.`,
+}
+
+func init() {
+	rootCmd.AddCommand(gistCmd)
+}
+
+// gistAPIURL is the GitHub REST endpoint for creating gists.
+const gistAPIURL = "https://api.github.com/gists"
+
+// gistFile is a file entry in a GitHub gist API response.
+type gistFile struct {
+	Filename  string `json:"filename"`
+	Content   string `json:"content"`
+	Truncated bool   `json:"truncated"`
+	RawURL    string `json:"raw_url"`
+}
+
+// gistRevision is an entry in a gist's history, newest first.
+type gistRevision struct {
+	Version     string    `json:"version"`
+	CommittedAt time.Time `json:"committed_at"`
+}
+
+// gistResponse is the subset of the GitHub gist API object gblog uses.
+type gistResponse struct {
+	ID          string              `json:"id"`
+	Description string              `json:"description"`
+	Public      bool                `json:"public"`
+	HTMLURL     string              `json:"html_url"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+	Files       map[string]gistFile `json:"files"`
+	History     []gistRevision      `json:"history"`
+}
+
+// ghAPI runs 'gh api' with the given arguments and optional JSON body,
+// returning the response body.
+func ghAPI(body interface{}, args ...string) ([]byte, error) {
+	args = append([]string{"api"}, args...)
+
+	cmd := exec.Command("gh", args...)
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode request: %w", err)
+		}
+		cmd.Args = append(cmd.Args, "--input", "-")
+		cmd.Stdin = bytes.NewReader(data)
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("gh api failed: %s", msg)
+		}
+		return nil, fmt.Errorf("gh api failed: %w", err)
+	}
+
+	return output, nil
+}
+
+// fetchGist returns the current state of a gist.
+func fetchGist(gistID string) (*gistResponse, error) {
+	return fetchGistPath("gists/" + gistID)
+}
+
+// fetchGistRevision returns a gist as it was at the given revision.
+func fetchGistRevision(gistID, version string) (*gistResponse, error) {
+	return fetchGistPath(fmt.Sprintf("gists/%s/%s", gistID, version))
+}
+
+func fetchGistPath(path string) (*gistResponse, error) {
+	output, err := ghAPI(nil, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var gist gistResponse
+	if err := json.Unmarshal(output, &gist); err != nil {
+		return nil, fmt.Errorf("failed to parse gist response: %w", err)
+	}
+
+	return &gist, nil
+}
+
+// patchGist applies a partial update to a gist and returns the gist's new
+// revision SHA (empty if the response had none). A nil entry in files
+// deletes that file from the gist.
+func patchGist(gistID string, description *string, files map[string]*gistFile) (string, error) {
+	body := map[string]interface{}{}
+	if description != nil {
+		body["description"] = *description
+	}
+	if files != nil {
+		patch := map[string]interface{}{}
+		for name, file := range files {
+			if file == nil {
+				patch[name] = nil
+				continue
+			}
+			entry := map[string]string{"content": file.Content}
+			if file.Filename != "" && file.Filename != name {
+				entry["filename"] = file.Filename
+			}
+			patch[name] = entry
+		}
+		body["files"] = patch
+	}
+
+	output, err := ghAPI(body, "-X", "PATCH", "gists/"+gistID)
+	if err != nil {
+		return "", err
+	}
+
+	var gist gistResponse
+	if err := json.Unmarshal(output, &gist); err != nil || len(gist.History) == 0 {
+		return "", nil
+	}
+	return gist.History[0].Version, nil
+}
+
+// latestGistRevision returns the SHA of a gist's newest revision, or ""
+// if it can't be fetched.
+func latestGistRevision(gistID string) string {
+	gist, err := fetchGist(gistID)
+	if err != nil || len(gist.History) == 0 {
+		return ""
+	}
+	return gist.History[0].Version
+}
+
+// createAnonymousGist creates a gist through the GitHub API without
+// authentication. GitHub has disabled anonymous gist creation since 2018,
+// so a 401 response is reported with that explanation.
+func createAnonymousGist(gistFiles []string, meta *PostMeta, description string) (string, string, error) {
+	files := map[string]map[string]string{}
+	for _, path := range gistFiles {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		files[filepath.Base(path)] = map[string]string{"content": string(content)}
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"description": description,
+		"public":      meta.Public,
+		"files":       files,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encode gist: %w", err)
+	}
+
+	logInfo(fmt.Sprintf("📤 Publishing post '%s' anonymously...", meta.Title), "post_id", meta.ID)
+
+	req, err := http.NewRequest(http.MethodPost, gistAPIURL, bytes.NewReader(body))
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create anonymous gist: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		return "", "", fmt.Errorf("GitHub rejected the anonymous gist (401): anonymous gists are no longer supported by GitHub; publish without --anonymous or use a secret gist")
+	case resp.StatusCode != http.StatusCreated:
+		return "", "", fmt.Errorf("failed to create anonymous gist: %s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+	}
+
+	var gist gistResponse
+	if err := json.Unmarshal(respBody, &gist); err != nil {
+		return "", "", fmt.Errorf("failed to parse gist response: %w", err)
+	}
+
+	return gist.HTMLURL, gist.ID, nil
+}
@@ -0,0 +1,161 @@
+// cmd/gistlist.go
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var gistListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List your gists and which posts track them",
+	Long: `List every gist on your GitHub account, newest first, and mark which
+ones belong to a local post. Backup gists written by 'gblog backup' are
+marked as such.
+
+With --untracked, only gists without a post are shown. --import-untracked
+creates a post for each of them, with the gist recorded as already
+published, which makes it easy to bring an existing gist collection
+into gblog.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		untrackedOnly, _ := cmd.Flags().GetBool("untracked")
+		importUntracked, _ := cmd.Flags().GetBool("import-untracked")
+		return listGists(untrackedOnly, importUntracked)
+	},
+}
+
+func init() {
+	gistCmd.AddCommand(gistListCmd)
+	gistListCmd.Flags().Bool("untracked", false, "Only show gists that no post tracks")
+	gistListCmd.Flags().Bool("import-untracked", false, "Create a post for every untracked gist")
+}
+
+func listGists(untrackedOnly, importUntracked bool) error {
+	// Check if gblog is initialized
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+
+	if err := checkGHAuth(); err != nil {
+		return err
+	}
+
+	gists, err := fetchUserGists()
+	if err != nil {
+		return err
+	}
+
+	posts, err := loadPosts()
+	if err != nil {
+		return err
+	}
+	tracked := map[string]string{}
+	for _, post := range posts {
+		if post.Meta.RemoteID != "" {
+			tracked[post.Meta.RemoteID] = post.Meta.ID
+		}
+	}
+
+	// Backups aren't posts and must never be imported as one
+	for _, gist := range gists {
+		if _, ok := gist.Files[backupFileName]; ok {
+			tracked[gist.ID] = "backup"
+		}
+	}
+
+	var untracked []gistResponse
+	for _, gist := range gists {
+		if _, ok := tracked[gist.ID]; !ok {
+			untracked = append(untracked, gist)
+		}
+	}
+
+	shown := gists
+	if untrackedOnly || importUntracked {
+		shown = untracked
+	}
+
+	if len(shown) == 0 {
+		if len(gists) == 0 {
+			fmt.Println("No gists found on your account.")
+		} else {
+			fmt.Println("Every gist is tracked by a post.")
+		}
+		return nil
+	}
+
+	fmt.Printf("%-32s %-9s %-7s %-10s %s\n", "Gist ID", "Post", "Public", "Updated", "Description")
+	fmt.Println(strings.Repeat("-", 100))
+	for _, gist := range shown {
+		var post string
+		if id, ok := tracked[gist.ID]; ok {
+			post = publishedColor.Render(fmt.Sprintf("%-9s", id))
+		} else {
+			post = draftColor.Render(fmt.Sprintf("%-9s", "untracked"))
+		}
+		visibility := "yes"
+		if !gist.Public {
+			visibility = "no"
+		}
+		desc := strings.SplitN(gist.Description, "\n", 2)[0]
+		if desc == "" {
+			desc = primaryGistFile(gist.Files)
+		}
+		desc = truncateText(desc, 45)
+		fmt.Printf("%-32s %s %-7s %-10s %s\n", gist.ID, post, visibility, gist.UpdatedAt.Local().Format("2006-01-02"), desc)
+	}
+
+	fmt.Println()
+	fmt.Printf("Total: %d | Tracked: %d | Untracked: %d\n", len(gists), len(gists)-len(untracked), len(untracked))
+
+	if !importUntracked {
+		if len(untracked) > 0 {
+			fmt.Println("💡 Run 'gblog gist list --import-untracked' to create posts for untracked gists")
+		}
+		return nil
+	}
+
+	fmt.Println()
+	imported := 0
+	for _, gist := range untracked {
+		postID, err := importGist(gist.ID)
+		if err != nil {
+			logWarnStderr(fmt.Sprintf("Warning: could not import gist %s: %v", gist.ID, err), "gist_id", gist.ID)
+			continue
+		}
+		logInfo(fmt.Sprintf("📥 Imported gist %s as post %s", gist.ID, postID), "post_id", postID, "gist_id", gist.ID)
+		imported++
+	}
+	logInfo(fmt.Sprintf("✅ Imported %d of %d untracked gist(s)", imported, len(untracked)))
+
+	return nil
+}
+
+// fetchUserGists returns all gists of the authenticated user, newest
+// first. File contents are not included.
+func fetchUserGists() ([]gistResponse, error) {
+	output, err := ghAPI(nil, "--paginate", "--slurp", "gists")
+	if err != nil {
+		return nil, err
+	}
+
+	var pages [][]gistResponse
+	if err := json.Unmarshal(output, &pages); err != nil {
+		return nil, fmt.Errorf("failed to parse gists: %w", err)
+	}
+	var gists []gistResponse
+	for _, page := range pages {
+		gists = append(gists, page...)
+	}
+	sort.SliceStable(gists, func(i, j int) bool {
+		return gists[i].UpdatedAt.After(gists[j].UpdatedAt)
+	})
+
+	return gists, nil
+}
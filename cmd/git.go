@@ -0,0 +1,57 @@
+// cmd/git.go
+package cmd
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// isGitRepo reports whether the current directory is inside a git work tree.
+func isGitRepo() bool {
+	output, err := exec.Command("git", "rev-parse", "--is-inside-work-tree").Output()
+	return err == nil && strings.TrimSpace(string(output)) == "true"
+}
+
+// commitPostChanges commits the post directory with message and pushes it.
+// It skips quietly when not in a git repo, when the post is gitignored
+// (private), or when there is nothing to commit.
+func commitPostChanges(postDir, message string) error {
+	if !isGitRepo() {
+		logInfo("💡 Not a git repository; skipping commit")
+		return nil
+	}
+
+	if err := exec.Command("git", "check-ignore", "-q", postDir).Run(); err == nil {
+		logInfo("🔒 Post is gitignored (private); skipping commit")
+		return nil
+	}
+
+	if output, err := exec.Command("git", "add", "--", postDir).CombinedOutput(); err != nil {
+		return fmt.Errorf("git add failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	// Exit status 0 means nothing staged for this path
+	if err := exec.Command("git", "diff", "--cached", "--quiet", "--", postDir).Run(); err == nil {
+		logInfo("💡 Nothing to commit")
+		return nil
+	}
+
+	logInfo("💾 Committing changes...", "message", message)
+	if output, err := exec.Command("git", "commit", "-m", message, "--", postDir).CombinedOutput(); err != nil {
+		return fmt.Errorf("git commit failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	// Only push when the branch tracks a remote
+	if err := exec.Command("git", "rev-parse", "--abbrev-ref", "--symbolic-full-name", "@{u}").Run(); err != nil {
+		logInfo("💡 No upstream branch configured; skipping push")
+		return nil
+	}
+
+	logInfo("📤 Pushing...")
+	if output, err := exec.Command("git", "push").CombinedOutput(); err != nil {
+		return fmt.Errorf("git push failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
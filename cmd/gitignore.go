@@ -0,0 +1,180 @@
+// cmd/gitignore.go
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// The private post entries gblog maintains in .gitignore live between
+// these markers, so user entries around them are left alone.
+const (
+	gitignoreBegin = "# BEGIN gblog private posts (managed by 'gblog doctor --repair-gitignore')"
+	gitignoreEnd   = "# END gblog private posts"
+)
+
+// gitignoreRequired are entries every blog's .gitignore needs, whether or
+// not the blog was created before gblog added them.
+var gitignoreRequired = []string{".gblog/cache/", ".gblog/.env"}
+
+// gitignoreDrift describes how .gitignore differs from what gblog expects.
+type gitignoreDrift struct {
+	missing []string // private posts and required entries not ignored
+	stale   []string // post entries for posts that are public or gone
+}
+
+func (d gitignoreDrift) empty() bool {
+	return len(d.missing) == 0 && len(d.stale) == 0
+}
+
+// privatePostEntries returns the sorted .gitignore entries for every
+// private post.
+func privatePostEntries() ([]string, error) {
+	posts, err := loadPosts()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []string
+	for _, post := range posts {
+		if !post.Meta.Public {
+			entries = append(entries, gitignorePostEntry(post.Dir))
+		}
+	}
+	sort.Strings(entries)
+	return entries, nil
+}
+
+// gitignorePostEntry returns the .gitignore line for a post directory
+// relative to posts/.
+func gitignorePostEntry(dir string) string {
+	return "posts/" + filepath.ToSlash(dir) + "/"
+}
+
+// isPostGitignoreEntry reports whether line ignores a post directory, like
+// the entries gblog writes for private posts (posts/0042-slug/).
+func isPostGitignoreEntry(line string) bool {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "posts/") || !strings.HasSuffix(line, "/") {
+		return false
+	}
+	id, _, ok := strings.Cut(path.Base(line), "-")
+	return ok && id != "" && strings.IndexFunc(id, func(r rune) bool { return !unicode.IsDigit(r) }) == -1
+}
+
+// checkGitignore compares the lines of a .gitignore with the private post
+// entries it should hold.
+func checkGitignore(content string, entries []string) gitignoreDrift {
+	present := map[string]bool{}
+	for _, line := range strings.Split(content, "\n") {
+		present[strings.TrimSpace(line)] = true
+	}
+
+	wanted := map[string]bool{}
+	var drift gitignoreDrift
+	for _, entry := range append(append([]string{}, gitignoreRequired...), entries...) {
+		wanted[entry] = true
+		if !present[entry] {
+			drift.missing = append(drift.missing, entry)
+		}
+	}
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if isPostGitignoreEntry(line) && !wanted[line] {
+			drift.stale = append(drift.stale, line)
+		}
+	}
+
+	return drift
+}
+
+// repairGitignoreContent rewrites the gblog-managed section of a
+// .gitignore to hold exactly entries, plus any required entries the rest
+// of the file lacks. Post entries outside the section are moved into it;
+// every other line is kept where it is. Without a section, one is added
+// after gblog's placeholder comment or at the end.
+func repairGitignoreContent(content string, entries []string) string {
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	if content == "" {
+		lines = nil
+	}
+
+	var kept []string
+	insertAt := -1
+	inSection := false
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == gitignoreBegin:
+			inSection = true
+			insertAt = len(kept)
+		case trimmed == gitignoreEnd && inSection:
+			inSection = false
+		case inSection, isPostGitignoreEntry(trimmed):
+			// Rewritten below
+		default:
+			kept = append(kept, line)
+		}
+	}
+
+	present := map[string]bool{}
+	for _, line := range kept {
+		present[strings.TrimSpace(line)] = true
+	}
+	section := []string{gitignoreBegin}
+	for _, entry := range gitignoreRequired {
+		if !present[entry] {
+			section = append(section, entry)
+		}
+	}
+	section = append(section, entries...)
+	section = append(section, gitignoreEnd)
+
+	if insertAt < 0 {
+		for i, line := range kept {
+			if strings.HasPrefix(strings.TrimSpace(line), "# gblog private posts will be added here") {
+				insertAt = i + 1
+				break
+			}
+		}
+	}
+	if insertAt < 0 {
+		insertAt = len(kept)
+		if insertAt > 0 && strings.TrimSpace(kept[insertAt-1]) != "" {
+			section = append([]string{""}, section...)
+		}
+	}
+
+	result := append(append(append([]string{}, kept[:insertAt]...), section...), kept[insertAt:]...)
+	return strings.Join(result, "\n") + "\n"
+}
+
+// addGitignoreEntry adds entry to the gblog-managed section of .gitignore,
+// or appends it when the file has no section yet.
+func addGitignoreEntry(entry string) error {
+	data, err := os.ReadFile(".gitignore")
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	lines := strings.Split(string(data), "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) == gitignoreEnd {
+			lines = append(lines[:i], append([]string{entry}, lines[i:]...)...)
+			return os.WriteFile(".gitignore", []byte(strings.Join(lines, "\n")), 0644)
+		}
+	}
+
+	file, err := os.OpenFile(".gitignore", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = fmt.Fprintln(file, entry)
+	return err
+}
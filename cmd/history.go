@@ -0,0 +1,165 @@
+// cmd/history.go
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// historyPath is the append-only log of actions gblog has taken.
+const historyPath = ".gblog/history.jsonl"
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Show a log of what gblog has done",
+	Long: `Show the actions recorded in .gblog/history.jsonl, newest first.
+
+Creating, publishing, updating, moving, reindexing, and rolling back
+posts are all recorded, so the log outlives the posts themselves.
+Filter with --post and --action.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		postID, _ := cmd.Flags().GetString("post")
+		action, _ := cmd.Flags().GetString("action")
+		limit, _ := cmd.Flags().GetInt("limit")
+		return showHistory(postID, action, limit)
+	},
+}
+
+// historyEntry is one line of the history log.
+type historyEntry struct {
+	Time   time.Time `json:"time"`
+	Action string    `json:"action"`
+	PostID string    `json:"post_id,omitempty"`
+	GistID string    `json:"gist_id,omitempty"`
+	Detail string    `json:"detail,omitempty"`
+}
+
+func init() {
+	rootCmd.AddCommand(historyCmd)
+	historyCmd.Flags().String("post", "", "Only show entries for this post ID")
+	historyCmd.Flags().String("action", "", "Only show entries for this action (e.g. publish)")
+	historyCmd.Flags().Int("limit", 0, "Show at most N entries (0 for all)")
+}
+
+// recordHistory appends an entry to the history log. The log is an audit
+// trail, so a failed write is reported but never fails the action itself.
+func recordHistory(action, postID, gistID, detail string) {
+	entry := historyEntry{
+		Time:   time.Now().UTC(),
+		Action: action,
+		PostID: postID,
+		GistID: gistID,
+		Detail: detail,
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not record history: %v\n", err)
+		return
+	}
+
+	f, err := os.OpenFile(historyPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not record history: %v\n", err)
+		return
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not record history: %v\n", err)
+	}
+}
+
+// loadHistory reads the history log in the order it was written.
+func loadHistory() ([]historyEntry, error) {
+	f, err := os.Open(historyPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", historyPath, err)
+	}
+	defer f.Close()
+
+	var entries []historyEntry
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		var entry historyEntry
+		if err := json.Unmarshal([]byte(text), &entry); err != nil {
+			return nil, fmt.Errorf("%s line %d: %w", historyPath, line, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", historyPath, err)
+	}
+
+	return entries, nil
+}
+
+func showHistory(postID, action string, limit int) error {
+	// Check if gblog is initialized
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+
+	if postID != "" {
+		id, err := normalizePostID(postID)
+		if err != nil {
+			return err
+		}
+		postID = id
+	}
+
+	entries, err := loadHistory()
+	if err != nil {
+		return err
+	}
+
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	dates := newDateFormatter(config, false)
+
+	shown := 0
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := entries[i]
+		if postID != "" && entry.PostID != postID {
+			continue
+		}
+		if action != "" && !strings.EqualFold(entry.Action, action) {
+			continue
+		}
+		if limit > 0 && shown == limit {
+			break
+		}
+
+		line := fmt.Sprintf("%-20s %-14s %-6s", dates.In(entry.Time).Format("2006-01-02 15:04:05"), entry.Action, entry.PostID)
+		if entry.GistID != "" {
+			line += " gist " + entry.GistID
+		}
+		if entry.Detail != "" {
+			line += "  " + entry.Detail
+		}
+		fmt.Println(strings.TrimRight(line, " "))
+		shown++
+	}
+
+	if shown == 0 {
+		fmt.Println("No history recorded yet.")
+	}
+
+	return nil
+}
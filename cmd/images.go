@@ -0,0 +1,156 @@
+// cmd/images.go
+package cmd
+
+import (
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// imageRefPattern matches markdown image references like ![alt](path "title")
+var imageRefPattern = regexp.MustCompile(`!\[([^\]]*)\]\(\s*<?([^)\s>]+)>?(\s+"[^"]*")?\s*\)`)
+
+type imageRef struct {
+	Line int
+	Path string
+}
+
+// isRelativeLink reports whether a link target points at a local file.
+// Anything with a scheme (https:, mailto:, ftp:, ...), protocol-relative
+// URLs, and in-page anchors are not.
+func isRelativeLink(target string) bool {
+	if strings.HasPrefix(target, "//") || strings.HasPrefix(target, "#") {
+		return false
+	}
+	if u, err := url.Parse(target); err == nil && u.Scheme != "" {
+		return false
+	}
+	return true
+}
+
+// findRelativeImages returns all local image references in markdown content.
+func findRelativeImages(content string) []imageRef {
+	var refs []imageRef
+	for i, line := range strings.Split(content, "\n") {
+		for _, match := range imageRefPattern.FindAllStringSubmatch(line, -1) {
+			if isRelativeLink(match[2]) {
+				refs = append(refs, imageRef{Line: i + 1, Path: match[2]})
+			}
+		}
+	}
+	return refs
+}
+
+// embedImages replaces local image references with base64 data URIs.
+// Images that can't be read are left untouched and returned as errors.
+func embedImages(content, baseDir string) (string, []error) {
+	var errs []error
+	result := imageRefPattern.ReplaceAllStringFunc(content, func(match string) string {
+		parts := imageRefPattern.FindStringSubmatch(match)
+		target := parts[2]
+		if !isRelativeLink(target) {
+			return match
+		}
+
+		data, err := os.ReadFile(filepath.Join(baseDir, filepath.FromSlash(target)))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not embed %s: %w", target, err))
+			return match
+		}
+
+		mimeType := mime.TypeByExtension(filepath.Ext(target))
+		if mimeType == "" {
+			mimeType = "application/octet-stream"
+		}
+
+		dataURI := fmt.Sprintf("data:%s;base64,%s", mimeType, base64.StdEncoding.EncodeToString(data))
+		return fmt.Sprintf("![%s](%s%s)", parts[1], dataURI, parts[3])
+	})
+	return result, errs
+}
+
+// prepareImages checks markdown files for relative image links. Without
+// embed it only warns; with embed it writes rewritten copies of the markdown
+// to a temp directory and substitutes them in the returned file list, and
+// leaves out the image files it inlined so they aren't uploaded twice. The
+// local source files are never modified. The returned cleanup func removes
+// any temporary files and is always safe to call.
+func prepareImages(postDir string, gistFiles []string, embed bool) ([]string, func(), error) {
+	cleanup := func() {}
+	tmpDir := ""
+	embeddedFiles := map[string]bool{}
+
+	result := make([]string, 0, len(gistFiles))
+	for _, file := range gistFiles {
+		if strings.ToLower(filepath.Ext(file)) != ".md" {
+			result = append(result, file)
+			continue
+		}
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			cleanup()
+			return nil, func() {}, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+
+		refs := findRelativeImages(string(data))
+		if len(refs) == 0 {
+			result = append(result, file)
+			continue
+		}
+
+		if !embed {
+			logWarn(fmt.Sprintf("⚠️  %s has relative image links that won't render on gist:", filepath.Base(file)))
+			for _, ref := range refs {
+				logInfo(fmt.Sprintf("    line %d: %s", ref.Line, ref.Path))
+			}
+			logInfo("💡 Use --embed-images to inline them as data URIs")
+			result = append(result, file)
+			continue
+		}
+
+		if tmpDir == "" {
+			tmpDir, err = os.MkdirTemp("", "gblog-publish-")
+			if err != nil {
+				return nil, func() {}, fmt.Errorf("failed to create temp directory: %w", err)
+			}
+			dir := tmpDir
+			cleanup = func() { os.RemoveAll(dir) }
+		}
+
+		embedded, errs := embedImages(string(data), postDir)
+		for _, err := range errs {
+			logWarn(fmt.Sprintf("⚠️  %v", err))
+		}
+
+		// Keep the original filename so the gist file name is unchanged
+		tmpPath := filepath.Join(tmpDir, filepath.Base(file))
+		if err := os.WriteFile(tmpPath, []byte(embedded), 0644); err != nil {
+			cleanup()
+			return nil, func() {}, fmt.Errorf("failed to write embedded markdown: %w", err)
+		}
+
+		logInfo(fmt.Sprintf("🖼️  Embedded %d image(s) in %s", len(refs)-len(errs), filepath.Base(file)))
+		result = append(result, tmpPath)
+		for _, ref := range refs {
+			embeddedFiles[filepath.Join(postDir, filepath.FromSlash(ref.Path))] = true
+		}
+	}
+
+	if len(embeddedFiles) > 0 {
+		kept := result[:0]
+		for _, file := range result {
+			if !embeddedFiles[filepath.Clean(file)] {
+				kept = append(kept, file)
+			}
+		}
+		result = kept
+	}
+
+	return result, cleanup, nil
+}
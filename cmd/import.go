@@ -0,0 +1,413 @@
+// cmd/import.go
+package cmd
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import <archive>",
+	Short: "Restore posts from an exported archive",
+	Long: `Restore the posts in an archive written by 'gblog export' into the
+current blog (run 'gblog init' first for a fresh one).
+
+Each post directory is recreated under posts/, without the archive's
+date folders, together with its .meta.json and files. A post whose ID
+is already taken here gets the next free ID instead. Posts whose gist
+is already tracked by a post here are skipped, so no gist ends up with
+two posts updating it. next_id is moved past the highest imported ID,
+and private posts are added to .gitignore.
+
+With --decrypt, an archive written by 'gblog export --encrypt' is
+decrypted with its passphrase (or GBLOG_PASSPHRASE) and then restored.
+Add -o to only write the decrypted zip to that path instead; an existing
+file there is only replaced with --force.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		decrypt, _ := cmd.Flags().GetBool("decrypt")
+		output, _ := cmd.Flags().GetString("output")
+		if output != "" && !decrypt {
+			return fmt.Errorf("-o only applies with --decrypt")
+		}
+		force, _ := cmd.Flags().GetBool("force")
+		if force && output == "" {
+			return fmt.Errorf("--force only applies with -o")
+		}
+		if decrypt && output != "" {
+			return decryptArchive(args[0], output, force)
+		}
+		if decrypt {
+			return importEncryptedArchive(args[0])
+		}
+		return importArchive(args[0])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+	importCmd.Flags().Bool("decrypt", false, "Decrypt an archive written by 'gblog export --encrypt' before restoring it")
+	importCmd.Flags().StringP("output", "o", "", "With --decrypt, only write the decrypted zip here")
+	importCmd.Flags().Bool("force", false, "With -o, replace an existing file")
+}
+
+// decryptArchive decrypts archive into output, which must not exist
+// unless force is set.
+func decryptArchive(archive, output string, force bool) error {
+	if _, err := os.Stat(output); err == nil && !force {
+		return fmt.Errorf("%s already exists (use --force to replace it)", output)
+	}
+
+	passphrase, err := readPassphrase(false)
+	if err != nil {
+		return err
+	}
+
+	if err := decryptFile(archive, output, passphrase); err != nil {
+		return err
+	}
+
+	logInfo(fmt.Sprintf("🔓 Decrypted %s to %s", archive, output), "archive", archive, "output", output)
+	return nil
+}
+
+// importEncryptedArchive decrypts archive to a temporary zip and
+// restores it.
+func importEncryptedArchive(archive string) error {
+	// Fail before asking for a passphrase
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+
+	tmpDir, err := os.MkdirTemp("", "gblog-import-")
+	if err != nil {
+		return fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	decrypted := filepath.Join(tmpDir, "gblog-export.zip")
+	passphrase, err := readPassphrase(false)
+	if err != nil {
+		return err
+	}
+	if err := decryptFile(archive, decrypted, passphrase); err != nil {
+		return err
+	}
+
+	return importArchive(decrypted)
+}
+
+// archivedPost is a post directory found in an export archive.
+type archivedPost struct {
+	dirName string               // directory name without date folders
+	meta    *PostMeta            // from the archived .meta.json, if any
+	files   map[string]*zip.File // keyed by path relative to the post
+}
+
+// importArchive restores the posts of an export archive into the
+// current blog.
+func importArchive(archive string) error {
+	// Check if gblog is initialized
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	reader, err := zip.OpenReader(archive)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", archive, err)
+	}
+	defer reader.Close()
+
+	manifest, posts, err := readArchivedPosts(&reader.Reader)
+	if err != nil {
+		return fmt.Errorf("%s: %w", archive, err)
+	}
+	if len(posts) == 0 {
+		return withExitCode(ExitNothingToDo, fmt.Errorf("no posts found in %s", archive))
+	}
+
+	// Two posts tracking one gist would both update it
+	existing, err := loadPosts()
+	if err != nil {
+		return err
+	}
+	tracked := map[string]string{}
+	for _, post := range existing {
+		if post.Meta.RemoteID != "" {
+			tracked[post.Meta.RemoteID] = post.Meta.ID
+		}
+	}
+
+	logInfo(fmt.Sprintf("📥 Importing %d posts from %s...", len(posts), archive), "archive", archive, "posts", len(posts))
+
+	imported, renumbered, skipped := 0, 0, 0
+	for _, post := range posts {
+		meta, err := archivedPostMeta(post, manifest)
+		if err != nil {
+			return err
+		}
+
+		if owner, ok := tracked[meta.RemoteID]; ok && meta.RemoteID != "" {
+			logWarn(fmt.Sprintf("  ⏭️  %s %s skipped: gist %s is already tracked by post %s", meta.ID, meta.Title, meta.RemoteID, owner),
+				"post_id", meta.ID, "gist_id", meta.RemoteID)
+			skipped++
+			continue
+		}
+
+		oldID := meta.ID
+		dirName := post.dirName
+		if postIDTaken(meta.ID) {
+			id := nextFreeID()
+			if id < config.NextID {
+				id = config.NextID
+			}
+			meta.ID = fmt.Sprintf("%04d", id)
+			_, slug, _ := strings.Cut(dirName, "-")
+			dirName = meta.ID + "-" + slug
+			renumbered++
+		}
+
+		dirPath := newPostDirPath(config, dirName, meta.CreatedAt)
+		postDir := filepath.Join("posts", dirPath)
+		if err := restorePostFiles(postDir, post.files); err != nil {
+			return fmt.Errorf("failed to import post %s: %w", oldID, err)
+		}
+		if err := savePostMeta(postDir, meta); err != nil {
+			return err
+		}
+
+		if meta.RemoteID != "" {
+			tracked[meta.RemoteID] = meta.ID
+		}
+
+		// Save as we go so next_id never falls behind a restored directory
+		if id, err := strconv.Atoi(meta.ID); err == nil && id >= config.NextID {
+			config.NextID = id + 1
+			if err := saveConfig(config); err != nil {
+				return err
+			}
+		}
+
+		if !meta.Public {
+			if err := addGitignoreEntry(gitignorePostEntry(dirPath)); err != nil {
+				logWarn(fmt.Sprintf("Warning: could not update .gitignore: %v", err), "post_id", meta.ID)
+			}
+		}
+
+		recordHistory("import", meta.ID, meta.RemoteID, archive)
+		if meta.ID != oldID {
+			logInfo(fmt.Sprintf("  📁 %s → %s %s (ID %s was taken)", oldID, meta.ID, meta.Title, oldID), "post_id", meta.ID, "old_id", oldID)
+		} else {
+			logInfo(fmt.Sprintf("  📁 %s %s", meta.ID, meta.Title), "post_id", meta.ID)
+		}
+		imported++
+	}
+
+	summary := fmt.Sprintf("✅ Imported %d posts", imported)
+	if renumbered > 0 {
+		summary += fmt.Sprintf(" (%d renumbered)", renumbered)
+	}
+	if skipped > 0 {
+		summary += fmt.Sprintf(", skipped %d already tracked", skipped)
+	}
+	logInfo(summary, "imported", imported, "renumbered", renumbered, "skipped", skipped)
+	return nil
+}
+
+// readArchivedPosts reads export-metadata.json and groups the archive's
+// files by post directory, in ID order. Date folders between posts/ and
+// the post directory are dropped.
+func readArchivedPosts(reader *zip.Reader) (exportManifest, []*archivedPost, error) {
+	var manifest exportManifest
+	byDir := map[string]*archivedPost{}
+	foundManifest := false
+
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		if file.Name == "export-metadata.json" {
+			data, err := readZipFile(file)
+			if err != nil {
+				return manifest, nil, err
+			}
+			if err := json.Unmarshal(data, &manifest); err != nil {
+				return manifest, nil, fmt.Errorf("invalid export-metadata.json: %w", err)
+			}
+			foundManifest = true
+			continue
+		}
+
+		dir, rel, ok := splitArchivedPath(file.Name)
+		if !ok {
+			continue
+		}
+		post := byDir[dir]
+		if post == nil {
+			post = &archivedPost{dirName: path.Base(dir), files: map[string]*zip.File{}}
+			byDir[dir] = post
+		}
+
+		if rel == ".meta.json" {
+			data, err := readZipFile(file)
+			if err != nil {
+				return manifest, nil, err
+			}
+			var meta PostMeta
+			if err := json.Unmarshal(data, &meta); err != nil {
+				return manifest, nil, fmt.Errorf("invalid %s: %w", file.Name, err)
+			}
+			post.meta = &meta
+			continue
+		}
+		post.files[rel] = file
+	}
+
+	if !foundManifest {
+		return manifest, nil, fmt.Errorf("not a gblog export (no export-metadata.json)")
+	}
+
+	posts := make([]*archivedPost, 0, len(byDir))
+	for _, post := range byDir {
+		posts = append(posts, post)
+	}
+	sort.Slice(posts, func(i, j int) bool {
+		return posts[i].dirName < posts[j].dirName
+	})
+
+	// Two date folders holding the same directory can't both be restored
+	for i := 1; i < len(posts); i++ {
+		if posts[i].dirName == posts[i-1].dirName {
+			return manifest, nil, fmt.Errorf("post directory %s appears more than once", posts[i].dirName)
+		}
+	}
+
+	return manifest, posts, nil
+}
+
+// splitArchivedPath splits a zip entry such as
+// posts/2025/01/02/0001-hello/img/a.png into the post directory
+// (posts/2025/01/02/0001-hello) and the path inside it (img/a.png). It
+// reports false for entries outside a post directory or with unsafe
+// paths.
+func splitArchivedPath(name string) (string, string, bool) {
+	if !strings.HasPrefix(name, "posts/") || strings.Contains(name, `\`) {
+		return "", "", false
+	}
+	parts := strings.Split(name, "/")
+	for _, part := range parts {
+		if part == "" || part == "." || part == ".." {
+			return "", "", false
+		}
+	}
+
+	// The post directory is the first one named like 0001-slug
+	for i := 1; i < len(parts)-1; i++ {
+		id, _, ok := strings.Cut(parts[i], "-")
+		if _, err := strconv.Atoi(id); ok && err == nil {
+			return strings.Join(parts[:i+1], "/"), strings.Join(parts[i+1:], "/"), true
+		}
+	}
+	return "", "", false
+}
+
+// archivedPostMeta returns the metadata for an archived post: its
+// .meta.json, or else the fields recorded for it in export-metadata.json.
+func archivedPostMeta(post *archivedPost, manifest exportManifest) (PostMeta, error) {
+	id, _, _ := strings.Cut(post.dirName, "-")
+
+	if post.meta != nil {
+		meta := *post.meta
+		if meta.ID == "" {
+			meta.ID = id
+		}
+		return meta, nil
+	}
+
+	for _, entry := range manifest.Posts {
+		if entry.ID != id {
+			continue
+		}
+		meta := PostMeta{
+			ID:        entry.ID,
+			Title:     entry.Title,
+			Public:    entry.Public,
+			CreatedAt: entry.CreatedAt,
+			Tags:      entry.Tags,
+			Backend:   entry.Backend,
+			RemoteURL: entry.RemoteURL,
+		}
+		// Older archives only have gist_url
+		if meta.RemoteURL == "" {
+			meta.RemoteURL = entry.GistURL
+		}
+		if meta.RemoteURL != "" {
+			meta.RemoteID = gistIDFromRef(meta.RemoteURL)
+		}
+		return meta, nil
+	}
+
+	return PostMeta{}, fmt.Errorf("post %s has no .meta.json and isn't listed in export-metadata.json", post.dirName)
+}
+
+// restorePostFiles writes an archived post's files into postDir, which
+// must not exist yet.
+func restorePostFiles(postDir string, files map[string]*zip.File) error {
+	if _, err := os.Stat(postDir); err == nil {
+		return fmt.Errorf("%s already exists", postDir)
+	}
+	if err := os.MkdirAll(postDir, 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", postDir, err)
+	}
+
+	for rel, file := range files {
+		data, err := readZipFile(file)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(postDir, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return fmt.Errorf("failed to create %s: %w", filepath.Dir(target), err)
+		}
+		if err := os.WriteFile(target, data, 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", target, err)
+		}
+	}
+	return nil
+}
+
+// readZipFile returns the contents of a file in a zip archive.
+func readZipFile(file *zip.File) ([]byte, error) {
+	rc, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s in archive: %w", file.Name, err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s in archive: %w", file.Name, err)
+	}
+	return data, nil
+}
+
+// postIDTaken reports whether a post with id already exists.
+func postIDTaken(id string) bool {
+	_, err := findPostDir(id)
+	return err == nil
+}
@@ -0,0 +1,264 @@
+// cmd/importgist.go
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var importGistCmd = &cobra.Command{
+	Use:   "import-gist <gist-id-or-url>",
+	Short: "Create a post from an existing gist",
+	Long: `Create a post from an existing gist. The gist's files are copied into a
+new post directory, and the gist is recorded as already published, so
+'gblog publish --update' updates it.
+
+With --fork, someone else's gist is first forked to your account and the
+fork is imported. The original gist is kept as upstream_id in
+.meta.json.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fork, _ := cmd.Flags().GetBool("fork")
+		return runImportGist(args[0], fork)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(importGistCmd)
+	importGistCmd.Flags().Bool("fork", false, "Fork the gist to your account and import the fork")
+}
+
+func runImportGist(ref string, fork bool) error {
+	// Check if gblog is initialized
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+
+	gistID := gistIDFromRef(ref)
+	if gistID == "" {
+		return fmt.Errorf("can't find a gist ID in %q", ref)
+	}
+
+	if err := checkGHAuth(); err != nil {
+		return err
+	}
+
+	importID := gistID
+	if fork {
+		logInfo(fmt.Sprintf("🍴 Forking gist %s...", gistID), "gist_id", gistID)
+		forkID, err := forkGist(gistID)
+		if err != nil {
+			return err
+		}
+		importID = forkID
+	}
+
+	// Importing a gist twice would leave two posts updating it
+	posts, err := loadPosts()
+	if err != nil {
+		return err
+	}
+	for _, post := range posts {
+		if post.Meta.RemoteID == importID {
+			return fmt.Errorf("gist %s is already tracked by post %s", importID, post.Meta.ID)
+		}
+	}
+
+	postID, err := importGist(importID)
+	if err != nil {
+		return err
+	}
+
+	postDir, err := findPostDir(postID)
+	if err != nil {
+		return err
+	}
+	meta, err := loadPostMeta(postDir)
+	if err != nil {
+		return err
+	}
+	if fork {
+		meta.UpstreamID = gistID
+		if err := savePostMeta(postDir, meta); err != nil {
+			return err
+		}
+	}
+
+	logInfo(fmt.Sprintf("✅ Imported gist %s as post %s: %s", importID, postID, meta.Title), "post_id", postID, "gist_id", importID)
+	logInfo(fmt.Sprintf("📁 Directory: %s", postDir), "post_id", postID, "dir", postDir)
+	if fork {
+		logInfo(fmt.Sprintf("🍴 Forked from gist %s", gistID), "post_id", postID, "upstream_id", gistID)
+	}
+	logInfo(fmt.Sprintf("🔗 Gist URL: %s", meta.RemoteURL), "post_id", postID, "gist_url", meta.RemoteURL)
+
+	return nil
+}
+
+// gistIDFromRef extracts the gist ID from a bare ID or a gist URL such as
+// https://gist.github.com/user/<id>.
+func gistIDFromRef(ref string) string {
+	ref = strings.TrimSuffix(strings.TrimSpace(ref), "/")
+	ref = strings.TrimSuffix(ref, ".git")
+	if i := strings.LastIndex(ref, "/"); i >= 0 {
+		ref = ref[i+1:]
+	}
+	if i := strings.IndexAny(ref, "#?"); i >= 0 {
+		ref = ref[:i]
+	}
+	return ref
+}
+
+// forkGist forks a gist to the authenticated user's account and returns
+// the fork's ID.
+func forkGist(gistID string) (string, error) {
+	output, err := ghAPI(nil, "-X", "POST", fmt.Sprintf("gists/%s/forks", gistID))
+	if err != nil {
+		return "", fmt.Errorf("failed to fork gist %s: %w", gistID, err)
+	}
+
+	var fork gistResponse
+	if err := json.Unmarshal(output, &fork); err != nil {
+		return "", fmt.Errorf("failed to parse fork response: %w", err)
+	}
+	if fork.ID == "" {
+		return "", fmt.Errorf("fork response for gist %s had no ID", gistID)
+	}
+	return fork.ID, nil
+}
+
+// importGist creates a post from an existing gist and records the gist as
+// already published, so later updates go to it. It returns the post ID.
+func importGist(gistID string) (string, error) {
+	gist, err := fetchGist(gistID)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch gist %s: %w", gistID, err)
+	}
+	if len(gist.Files) == 0 {
+		return "", fmt.Errorf("gist %s has no files", gistID)
+	}
+
+	// Large files are truncated in API responses
+	contents := map[string]string{}
+	for name, file := range gist.Files {
+		if err := validatePostFilename(name); err != nil {
+			return "", fmt.Errorf("gist %s: %w", gistID, err)
+		}
+		content := file.Content
+		if file.Truncated {
+			if content, err = fetchRawGistFile(file.RawURL); err != nil {
+				return "", fmt.Errorf("failed to download %s: %w", name, err)
+			}
+		}
+		contents[name] = content
+	}
+
+	primary := primaryGistFile(gist.Files)
+	title := gistTitle(gist, contents[primary])
+
+	postID, err := createPost(postSpec{
+		Title:          title,
+		Description:    gist.Description,
+		Public:         gist.Public,
+		PublicSet:      true,
+		Filename:       primary,
+		Content:        contents[primary],
+		AllowDuplicate: true,
+		Quiet:          true,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	postDir, err := findPostDir(postID)
+	if err != nil {
+		return "", err
+	}
+
+	for name, content := range contents {
+		if name == primary {
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(postDir, name), []byte(content), 0644); err != nil {
+			return "", fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+
+	meta, err := loadPostMeta(postDir)
+	if err != nil {
+		return "", err
+	}
+	meta.CreatedAt = gist.CreatedAt
+	meta.RemoteID = gist.ID
+	meta.RemoteURL = gist.HTMLURL
+	meta.Backend = gistBackend
+	now := time.Now().UTC()
+	meta.PublishedAt = &now
+	if len(gist.History) > 0 {
+		meta.RemoteRevision = gist.History[0].Version
+	}
+	if err := savePostMeta(postDir, meta); err != nil {
+		return "", err
+	}
+
+	recordHistory("import", postID, gist.ID, gist.HTMLURL)
+
+	return postID, nil
+}
+
+// primaryGistFile picks the file to treat as the post: the first markdown
+// file by name, or the first file when there's none.
+func primaryGistFile(files map[string]gistFile) string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		switch strings.ToLower(filepath.Ext(name)) {
+		case ".md", ".markdown":
+			return name
+		}
+	}
+	return names[0]
+}
+
+// gistTitle derives a post title from the gist's markdown heading, its
+// description, or, failing both, the primary file name.
+func gistTitle(gist *gistResponse, primaryContent string) string {
+	if title := markdownTitle(primaryContent); title != "" {
+		return title
+	}
+	if desc := strings.TrimSpace(strings.SplitN(gist.Description, "\n", 2)[0]); desc != "" {
+		return desc
+	}
+	return primaryGistFile(gist.Files)
+}
+
+// fetchRawGistFile downloads a gist file from its raw URL.
+func fetchRawGistFile(rawURL string) (string, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Get(rawURL)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -16,11 +16,29 @@ import (
 )
 
 type Config struct {
-	NextID        int    `json:"next_id"`
-	GitHubUser    string `json:"github_user,omitempty"`
-	DefaultPublic bool   `json:"default_public"`
-	BlogPath      string `json:"blog_path"`
-	RepoName      string `json:"repo_name"`
+	NextID           int               `json:"next_id"`
+	GitHubUser       string            `json:"github_user,omitempty"`
+	DefaultPublic    bool              `json:"default_public"`
+	BlogPath         string            `json:"blog_path"`
+	RepoName         string            `json:"repo_name"`
+	Theme            map[string]string `json:"theme,omitempty"`
+	Timezone         string            `json:"timezone,omitempty"`
+	DateFormat       string            `json:"date_format,omitempty"`
+	AutoCommit       bool              `json:"auto_commit,omitempty"`
+	LastBackup       *BackupInfo       `json:"last_backup,omitempty"`
+	OrganizeByYear   bool              `json:"organize_by_year,omitempty"`
+	OpenAfterPublish *bool             `json:"open_after_publish,omitempty"`
+	Backend          string            `json:"backend,omitempty"`
+
+	// AcknowledgedPublic records that the user was told what publishing
+	// a public gist means, so the full explanation is only shown once.
+	AcknowledgedPublic bool `json:"acknowledged_public,omitempty"`
+}
+
+// openAfterPublish reports whether publish should open the gist in a
+// browser by default. Configs without the setting default to true.
+func (c Config) openAfterPublish() bool {
+	return c.OpenAfterPublish == nil || *c.OpenAfterPublish
 }
 
 type initModel struct {
@@ -29,31 +47,102 @@ type initModel struct {
 	blogPath    textinput.Model
 	createRepo  bool
 	currentUser string
+	opts        initOptions
 	err         error
 	quitting    bool
 }
 
+// initOptions holds the flag-driven settings for a new blog project.
+type initOptions struct {
+	defaultPublic bool
+	visibilitySet bool   // --public or --private was given explicitly
+	templateRepo  string // repository to scaffold the blog from
+	yes           bool   // skip the confirmation summary
+	force         bool   // allow creating a blog inside another one
+	here          bool   // turn the current directory into the blog
+	branch        string // name of the new repository's branch
+	minimal       bool   // skip the README and write a bare .gitignore
+}
+
 var initCmd = &cobra.Command{
 	Use:   "init [blog-name]",
 	Short: "Initialize a new gblog project",
 	Long: `Initialize a new gblog project with automatic repository setup.
 
 This creates a new blog repository, sets up the directory structure,
-and configures everything needed to start your gist-powered blog.`,
+and configures everything needed to start your gist-powered blog.
+
+Use --template-repo to start from a shared scaffold instead. The template
+is cloned without its history, and a .gblog/config.json is created if it
+doesn't have one.
+
+Use --here to turn the current directory, which may already hold files
+or a git repository, into the blog. The blog name defaults to the
+directory name, git init only runs when there's no .git yet, existing
+README.md and .gitignore files are kept (gblog's ignore rules are
+appended), and only gblog's own files go into the initial commit.
+
+A new repository's branch is named main, whatever git's
+init.defaultBranch says, so it matches what is pushed. Use --branch to
+pick another name. An existing repository keeps its current branch.
+
+--minimal creates only .gblog/config.json, posts/, and a short
+.gitignore (OS files and *.zip), without the README.`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		private, _ := cmd.Flags().GetBool("private")
+		templateRepo, _ := cmd.Flags().GetString("template-repo")
+		yes, _ := cmd.Flags().GetBool("yes")
+		force, _ := cmd.Flags().GetBool("force")
+		here, _ := cmd.Flags().GetBool("here")
+		branch, _ := cmd.Flags().GetString("branch")
+		minimal, _ := cmd.Flags().GetBool("minimal")
+		if strings.TrimSpace(branch) == "" {
+			return fmt.Errorf("--branch cannot be empty")
+		}
+		opts := initOptions{
+			here:          here,
+			branch:        branch,
+			minimal:       minimal,
+			yes:           yes,
+			force:         force,
+			defaultPublic: !private,
+			visibilitySet: cmd.Flags().Changed("public") || cmd.Flags().Changed("private"),
+			templateRepo:  templateRepo,
+		}
+
+		if here {
+			if templateRepo != "" {
+				return fmt.Errorf("--here cannot be combined with --template-repo")
+			}
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			return initializeBlogHere(name, opts)
+		}
 		if len(args) > 0 {
-			return initializeBlogDirect(args[0])
+			return initializeBlogDirect(args[0], opts)
 		}
-		return initializeBlogInteractive()
+		return initializeBlogInteractive(opts)
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(initCmd)
+	initCmd.Flags().Bool("public", false, "Make new posts public by default (the default)")
+	initCmd.Flags().Bool("private", false, "Make new posts private by default")
+	initCmd.MarkFlagsMutuallyExclusive("public", "private")
+	initCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	initCmd.Flags().Bool("force", false, "Create the blog even inside an existing gblog project")
+	initCmd.Flags().Bool("here", false, "Initialize the blog in the current directory")
+	initCmd.Flags().String("branch", "main", "Name of the new repository's branch")
+	initCmd.Flags().String("template-repo", "", "Scaffold the blog from a template repository (git URL or path)")
+	initCmd.Flags().Bool("minimal", false, "Only create the config, posts/, and a short .gitignore (no README)")
+	initCmd.MarkFlagsMutuallyExclusive("minimal", "template-repo")
 }
 
-func initializeBlogInteractive() error {
+func initializeBlogInteractive(opts initOptions) error {
 	// Get current user for defaults
 	currentUser, err := user.Current()
 	if err != nil {
@@ -68,6 +157,7 @@ func initializeBlogInteractive() error {
 	m := initModel{
 		step:        0,
 		currentUser: username,
+		opts:        opts,
 	}
 
 	// Initialize blog name input
@@ -102,7 +192,7 @@ func initializeBlogInteractive() error {
 	return createBlogProject(finalModel.(initModel))
 }
 
-func initializeBlogDirect(blogName string) error {
+func initializeBlogDirect(blogName string, opts initOptions) error {
 	currentUser, err := user.Current()
 	if err != nil {
 		return fmt.Errorf("failed to get current user: %w", err)
@@ -114,6 +204,7 @@ func initializeBlogDirect(blogName string) error {
 	m := initModel{
 		currentUser: currentUser.Username,
 		createRepo:  true,
+		opts:        opts,
 	}
 	m.blogName = textinput.New()
 	m.blogName.SetValue(blogName)
@@ -123,6 +214,41 @@ func initializeBlogDirect(blogName string) error {
 	return createBlogProject(m)
 }
 
+// initializeBlogHere sets up the blog in the current directory, named
+// blogName or, when empty, after the directory.
+func initializeBlogHere(blogName string, opts initOptions) error {
+	if _, err := os.Stat(filepath.Join(".gblog", "config.json")); err == nil {
+		return fmt.Errorf("this directory is already a gblog project (.gblog/config.json exists)")
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get current directory: %w", err)
+	}
+	if blogName == "" {
+		blogName = filepath.Base(cwd)
+	}
+
+	currentUser, err := user.Current()
+	if err != nil {
+		return fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	// A repository that already exists is left to its owner
+	_, err = os.Stat(".git")
+	m := initModel{
+		currentUser: currentUser.Username,
+		createRepo:  os.IsNotExist(err),
+		opts:        opts,
+	}
+	m.blogName = textinput.New()
+	m.blogName.SetValue(blogName)
+	m.blogPath = textinput.New()
+	m.blogPath.SetValue(".")
+
+	return createBlogProject(m)
+}
+
 func (m initModel) Init() tea.Cmd {
 	return textinput.Blink
 }
@@ -222,99 +348,209 @@ func createBlogProject(m initModel) error {
 	blogName := m.blogName.Value()
 	blogPath := m.blogPath.Value()
 
-	fmt.Printf("🚀 Creating blog project: %s\n", blogName)
-	fmt.Printf("📁 Location: %s\n", blogPath)
+	// Never nest a blog inside another one by accident
+	if absPath, err := filepath.Abs(blogPath); err == nil && !m.opts.force {
+		if root := findProjectFrom(absPath); root != "" {
+			return fmt.Errorf("%s is inside the gblog project at %s (use --force to create it anyway)", blogPath, root)
+		}
+	}
+
+	if !m.opts.yes {
+		printInitSummary(m)
+		ok, err := confirm("Continue?")
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+		fmt.Println()
+	}
+
+	logInfo(fmt.Sprintf("🚀 Creating blog project: %s", blogName), "blog", blogName)
+	logInfo(fmt.Sprintf("📁 Location: %s", blogPath), "path", blogPath)
+
+	// Start from the template's files, if any
+	if m.opts.templateRepo != "" {
+		if err := cloneTemplateRepo(m.opts.templateRepo, blogPath); err != nil {
+			return err
+		}
+	}
 
 	// Create blog directory
 	if err := os.MkdirAll(blogPath, 0755); err != nil {
 		return fmt.Errorf("failed to create blog directory: %w", err)
 	}
 
-	// Change to blog directory
-	if err := os.Chdir(blogPath); err != nil {
-		return fmt.Errorf("failed to change to blog directory: %w", err)
-	}
-
 	// Initialize git repository
-	fmt.Println("📋 Initializing git repository...")
-	if err := runCommand("git", "init"); err != nil {
-		return fmt.Errorf("failed to initialize git repository: %w", err)
+	branch := m.opts.branch
+	if branch == "" {
+		branch = "main"
+	}
+	existingRepo := false
+	if _, err := os.Stat(filepath.Join(blogPath, ".git")); m.opts.here && err == nil {
+		logInfo("📋 Using the existing git repository", "path", blogPath)
+		existingRepo = true
+	} else {
+		logInfo("📋 Initializing git repository...", "path", blogPath)
+		if err := runCommandIn(blogPath, "git", "init"); err != nil {
+			return fmt.Errorf("failed to initialize git repository: %w", err)
+		}
 	}
 
 	// Create blog structure
-	if err := createBlogStructure(blogName); err != nil {
+	if m.opts.templateRepo != "" {
+		if err := prepareTemplateStructure(blogPath, blogName, m.opts); err != nil {
+			return err
+		}
+	} else if err := createBlogStructure(blogPath, blogName, m.opts); err != nil {
 		return err
 	}
 
 	// Create initial commit
-	fmt.Println("💾 Creating initial commit...")
-	if err := runCommand("git", "add", "."); err != nil {
+	logInfo("💾 Creating initial commit...")
+	addPaths := []string{"."}
+	if m.opts.here {
+		// Leave whatever else is in the directory to its owner
+		addPaths = []string{".gblog", ".gitignore"}
+		if _, err := os.Stat(filepath.Join(blogPath, "README.md")); err == nil {
+			addPaths = append(addPaths, "README.md")
+		}
+	}
+	if err := runCommandIn(blogPath, "git", append([]string{"add"}, addPaths...)...); err != nil {
 		return fmt.Errorf("failed to add files to git: %w", err)
 	}
 
-	if err := runCommand("git", "commit", "-m", "Initial commit: Initialize gblog"); err != nil {
+	if err := runCommandIn(blogPath, "git", "commit", "-m", "Initial commit: Initialize gblog"); err != nil {
 		return fmt.Errorf("failed to create initial commit: %w", err)
 	}
 
+	// git init names the branch after init.defaultBranch (master on older
+	// setups); rename it so it matches what gets pushed
+	if existingRepo {
+		branch = "HEAD"
+	} else if err := runCommandIn(blogPath, "git", "branch", "-M", branch); err != nil {
+		return fmt.Errorf("failed to name the branch %s: %w", branch, err)
+	}
+
 	// Create GitHub repository if requested
 	if m.createRepo {
-		fmt.Println("🌐 Creating GitHub repository...")
-		if err := createGitHubRepo(blogName); err != nil {
-			fmt.Printf("⚠️  Could not create GitHub repository: %v\n", err)
-			fmt.Println("You can create it manually later with: gh repo create")
+		logInfo("🌐 Creating GitHub repository...", "blog", blogName)
+		if err := createGitHubRepo(blogPath, blogName); err != nil {
+			logWarn(fmt.Sprintf("⚠️  Could not create GitHub repository: %v", err), "blog", blogName)
+			logInfo("You can create it manually later with: gh repo create")
 		} else {
-			fmt.Println("📤 Pushing to GitHub...")
-			if err := runCommand("git", "push", "-u", "origin", "main"); err != nil {
-				fmt.Printf("⚠️  Could not push to GitHub: %v\n", err)
+			logInfo("📤 Pushing to GitHub...", "branch", branch)
+			if err := runCommandIn(blogPath, "git", "push", "-u", "origin", branch); err != nil {
+				logWarn(fmt.Sprintf("⚠️  Could not push to GitHub: %v", err), "branch", branch)
 			}
 		}
 	}
 
+	if logger != nil {
+		logger.Info("created blog", "blog", blogName, "path", blogPath)
+		return nil
+	}
+
 	fmt.Printf("✅ Blog '%s' created successfully!\n", blogName)
 	fmt.Println()
 	fmt.Println("Next steps:")
-	fmt.Printf("  1. cd %s\n", blogPath)
-	fmt.Println("  2. gblog new              # Create your first post")
-	fmt.Println("  3. gblog publish 0001     # Publish when ready")
+	step := 1
+	if !m.opts.here {
+		fmt.Printf("  %d. cd %s\n", step, blogPath)
+		step++
+	}
+	fmt.Printf("  %d. gblog new              # Create your first post\n", step)
+	fmt.Printf("  %d. gblog publish 0001     # Publish when ready\n", step+1)
 	fmt.Println()
 	fmt.Printf("📂 Blog directory: %s\n", blogPath)
 
 	return nil
 }
 
-func createBlogStructure(blogName string) error {
+// printInitSummary lists everything createBlogProject is about to create.
+func printInitSummary(m initModel) {
+	blogPath := m.blogPath.Value()
+
+	fmt.Println("This will create:")
+	if _, err := os.Stat(blogPath); err == nil {
+		fmt.Printf("  📁 Blog in existing directory %s\n", blogPath)
+	} else {
+		fmt.Printf("  📁 Directory %s\n", blogPath)
+	}
+	if m.opts.templateRepo != "" {
+		fmt.Printf("  📦 Files from template %s\n", m.opts.templateRepo)
+	} else if m.opts.minimal {
+		fmt.Println("  📄 .gblog/config.json, posts/, .gitignore")
+	} else {
+		fmt.Println("  📄 .gblog/config.json, posts/, README.md, .gitignore")
+	}
+	fmt.Println("  📋 A git repository with an initial commit")
+	if m.createRepo {
+		fmt.Printf("  🌐 A public GitHub repository named %s\n", m.blogName.Value())
+	}
+	if m.opts.defaultPublic {
+		fmt.Println("  🌍 New posts will be public by default")
+	} else {
+		fmt.Println("  🔒 New posts will be private by default")
+	}
+	fmt.Println()
+}
+
+// createBlogStructure writes the default blog layout into blogPath.
+func createBlogStructure(blogPath, blogName string, opts initOptions) error {
 	// Create .gblog directory
-	if err := os.MkdirAll(".gblog", 0755); err != nil {
+	if err := os.MkdirAll(filepath.Join(blogPath, ".gblog"), 0755); err != nil {
 		return fmt.Errorf("failed to create .gblog directory: %w", err)
 	}
 
 	// Create posts directory
-	if err := os.MkdirAll("posts", 0755); err != nil {
+	if err := os.MkdirAll(filepath.Join(blogPath, "posts"), 0755); err != nil {
 		return fmt.Errorf("failed to create posts directory: %w", err)
 	}
 
 	// Create initial config
 	config := Config{
 		NextID:        1,
-		DefaultPublic: true,
+		GitHubUser:    getGitHubUser(),
+		DefaultPublic: opts.defaultPublic,
 		BlogPath:      ".",
 		RepoName:      blogName,
 	}
 
-	configPath := filepath.Join(".gblog", "config.json")
-	configFile, err := os.Create(configPath)
-	if err != nil {
-		return fmt.Errorf("failed to create config file: %w", err)
+	configPath := filepath.Join(blogPath, ".gblog", "config.json")
+	if err := writeJSONAtomic(configPath, config); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
 	}
-	defer configFile.Close()
 
-	encoder := json.NewEncoder(configFile)
-	encoder.SetIndent("", "  ")
-	if err := encoder.Encode(config); err != nil {
-		return fmt.Errorf("failed to write config: %w", err)
+	gitignore := blogGitignore
+	if opts.minimal {
+		gitignore = minimalGitignore
+	} else if err := writeBlogReadme(blogPath, blogName, opts); err != nil {
+		return err
+	}
+
+	// Create .gitignore for blog repo
+	gitignorePath := filepath.Join(blogPath, ".gitignore")
+	if existing, err := os.ReadFile(gitignorePath); opts.here && err == nil {
+		if !strings.HasSuffix(string(existing), "\n") && len(existing) > 0 {
+			existing = append(existing, '\n')
+		}
+		existing = append(existing, "\n"+gitignore...)
+		if err := os.WriteFile(gitignorePath, existing, 0644); err != nil {
+			return fmt.Errorf("failed to update .gitignore: %w", err)
+		}
+	} else if err := os.WriteFile(gitignorePath, []byte(gitignore), 0644); err != nil {
+		return fmt.Errorf("failed to create .gitignore: %w", err)
 	}
 
-	// Create README
+	return nil
+}
+
+// writeBlogReadme writes the default README.md into blogPath, keeping an
+// existing one with --here.
+func writeBlogReadme(blogPath, blogName string, opts initOptions) error {
 	readmeContent := fmt.Sprintf(`# %s
 
 A gist-powered blog created with [gblog](https://github.com/onprema/gblog).
@@ -348,12 +584,20 @@ Each post contains a descriptively named markdown file and any auxiliary files.
 5. `+"`gblog publish <id> --update`"+` - Update gist after changes
 `, blogName)
 
-	if err := os.WriteFile("README.md", []byte(readmeContent), 0644); err != nil {
+	readmePath := filepath.Join(blogPath, "README.md")
+	if _, err := os.Stat(readmePath); opts.here && err == nil {
+		logInfo("📄 Keeping the existing README.md")
+	} else if err := os.WriteFile(readmePath, []byte(readmeContent), 0644); err != nil {
 		return fmt.Errorf("failed to create README: %w", err)
 	}
 
-	// Create .gitignore for blog repo
-	blogGitignore := `# gblog private posts will be added here automatically
+	return nil
+}
+
+// blogGitignore is the .gitignore written into new blog repositories.
+const blogGitignore = `# gblog private posts will be added here automatically
+` + gitignoreBegin + `
+` + gitignoreEnd + `
 
 # OS generated files
 .DS_Store
@@ -366,16 +610,92 @@ Thumbs.db
 
 # Export files
 *.zip
+
+# gblog caches
+.gblog/cache/
+
+# Per-project secrets such as GITHUB_TOKEN
+.gblog/.env
 `
 
-	if err := os.WriteFile(".gitignore", []byte(blogGitignore), 0644); err != nil {
-		return fmt.Errorf("failed to create .gitignore: %w", err)
+// minimalGitignore is the .gitignore written by 'gblog init --minimal'.
+const minimalGitignore = `.DS_Store
+Thumbs.db
+*.zip
+`
+
+// cloneTemplateRepo clones a template repository into blogPath and
+// removes its git history so the blog starts fresh.
+func cloneTemplateRepo(repo, blogPath string) error {
+	if entries, err := os.ReadDir(blogPath); err == nil && len(entries) > 0 {
+		return fmt.Errorf("%s already exists and is not empty", blogPath)
+	}
+
+	logInfo(fmt.Sprintf("📦 Cloning template %s...", repo), "template", repo)
+	if err := runCommand("git", "clone", "--depth", "1", repo, blogPath); err != nil {
+		return fmt.Errorf("failed to clone template repository: %w", err)
+	}
+
+	if err := os.RemoveAll(filepath.Join(blogPath, ".git")); err != nil {
+		return fmt.Errorf("failed to remove template git history: %w", err)
+	}
+
+	return nil
+}
+
+// prepareTemplateStructure fills in whatever a cloned template in blogPath
+// is missing: the posts directory, .gitignore, and a valid
+// .gblog/config.json.
+func prepareTemplateStructure(blogPath, blogName string, opts initOptions) error {
+	postsDir := filepath.Join(blogPath, "posts")
+	if err := os.MkdirAll(postsDir, 0755); err != nil {
+		return fmt.Errorf("failed to create posts directory: %w", err)
+	}
+
+	gitignorePath := filepath.Join(blogPath, ".gitignore")
+	if _, err := os.Stat(gitignorePath); os.IsNotExist(err) {
+		if err := os.WriteFile(gitignorePath, []byte(blogGitignore), 0644); err != nil {
+			return fmt.Errorf("failed to create .gitignore: %w", err)
+		}
+	}
+
+	config := Config{
+		NextID:        1,
+		DefaultPublic: opts.defaultPublic,
+		BlogPath:      ".",
+	}
+	configPath := filepath.Join(blogPath, ".gblog", "config.json")
+	if data, err := os.ReadFile(configPath); err == nil {
+		logInfo("⚙️  Using the template's .gblog/config.json")
+		if err := json.Unmarshal(data, &config); err != nil {
+			return fmt.Errorf("template config is invalid: %w", err)
+		}
+		if err := validateTheme(config.Theme); err != nil {
+			return fmt.Errorf("template config is invalid: %w", err)
+		}
+		if opts.visibilitySet {
+			config.DefaultPublic = opts.defaultPublic
+		}
+	} else if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		return fmt.Errorf("failed to create .gblog directory: %w", err)
 	}
 
+	// The config belongs to this blog now, not the template
+	config.RepoName = blogName
+	if config.GitHubUser == "" {
+		config.GitHubUser = getGitHubUser()
+	}
+	if next := nextFreeIDIn(postsDir); config.NextID < next {
+		config.NextID = next
+	}
+
+	if err := writeJSONAtomic(configPath, config); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
 	return nil
 }
 
-func createGitHubRepo(repoName string) error {
+func createGitHubRepo(blogPath, repoName string) error {
 	// Check if gh CLI is available and authenticated
 	if err := runCommand("gh", "auth", "status"); err != nil {
 		return fmt.Errorf("GitHub CLI not authenticated. Run 'gh auth login' first")
@@ -383,17 +703,99 @@ func createGitHubRepo(repoName string) error {
 
 	// Create the repository
 	description := fmt.Sprintf("A gist-powered blog created with gblog")
-	return runCommand("gh", "repo", "create", repoName, "--public", "--description", description, "--source=.", "--remote=origin", "--push")
+	return runCommandIn(blogPath, "gh", "repo", "create", repoName, "--public", "--description", description, "--source=.", "--remote=origin", "--push")
+}
+
+// getGitHubUser returns the login of the authenticated gh user, or an
+// empty string if gh is unavailable or not authenticated.
+func getGitHubUser() string {
+	output, err := exec.Command("gh", "api", "user", "--jq", ".login").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
 }
 
 func runCommand(name string, args ...string) error {
+	return runCommandIn("", name, args...)
+}
+
+// runCommandIn is runCommand with the working directory set to dir.
+func runCommandIn(dir, name string, args ...string) error {
 	cmd := exec.Command(name, args...)
+	cmd.Dir = dir
 	cmd.Stdout = os.Stdout
+	if logger != nil {
+		// Keep stdout clean for the JSON records' consumers
+		cmd.Stdout = nil
+	}
 	cmd.Stderr = os.Stderr
 	return cmd.Run()
 }
 
 func initializeBlog() error {
 	// Legacy function - redirect to interactive
-	return initializeBlogInteractive()
+	return initializeBlogInteractive(initOptions{defaultPublic: true})
+}
+
+// loadConfig reads the project configuration from .gblog/config.json.
+func loadConfig() (Config, error) {
+	var config Config
+
+	configData, err := os.ReadFile(".gblog/config.json")
+	if os.IsNotExist(err) {
+		return config, errNotInitialized()
+	}
+	if err != nil {
+		return config, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	if err := json.Unmarshal(configData, &config); err != nil {
+		return config, fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	return config, nil
+}
+
+// saveConfig writes the project configuration to .gblog/config.json.
+func saveConfig(config Config) error {
+	if err := writeJSONAtomic(".gblog/config.json", config); err != nil {
+		return fmt.Errorf("failed to write updated config: %w", err)
+	}
+
+	return nil
+}
+
+// errNotInitialized explains that the current directory isn't a gblog
+// project, pointing at an enclosing project if there is one.
+func errNotInitialized() error {
+	if dir := findProjectAbove(); dir != "" {
+		return withExitCode(ExitNotInitialized, fmt.Errorf("gblog not initialized here. Found a gblog project at %s; run from there or use -C", dir))
+	}
+	return withExitCode(ExitNotInitialized, fmt.Errorf("gblog not initialized. Run 'gblog init' first"))
+}
+
+// findProjectAbove returns the nearest parent of the working directory
+// containing .gblog/config.json, or an empty string if there is none.
+func findProjectAbove() string {
+	dir, err := os.Getwd()
+	if err != nil || filepath.Dir(dir) == dir {
+		return ""
+	}
+	return findProjectFrom(filepath.Dir(dir))
+}
+
+// findProjectFrom returns dir or its nearest ancestor containing
+// .gblog/config.json, or an empty string if there is none.
+func findProjectFrom(dir string) string {
+	for {
+		if _, err := os.Stat(filepath.Join(dir, ".gblog", "config.json")); err == nil {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
 }
@@ -0,0 +1,63 @@
+// cmd/layout.go
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// isYearDir reports whether name is a year directory like "2024" used by
+// the organize_by_year layout, as opposed to a "0001-slug" post directory.
+func isYearDir(name string) bool {
+	if len(name) != 4 {
+		return false
+	}
+	_, err := strconv.Atoi(name)
+	return err == nil
+}
+
+// listPostDirs returns the post directories under postsDir, relative to
+// it. Both the flat layout (0001-slug) and the organize_by_year layout
+// (2024/0001-slug) are scanned, so blogs can switch between them.
+func listPostDirs(postsDir string) ([]string, error) {
+	entries, err := os.ReadDir(postsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var dirs []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if !isYearDir(entry.Name()) {
+			dirs = append(dirs, entry.Name())
+			continue
+		}
+
+		yearEntries, err := os.ReadDir(filepath.Join(postsDir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, yearEntry := range yearEntries {
+			if yearEntry.IsDir() && strings.Contains(yearEntry.Name(), "-") {
+				dirs = append(dirs, filepath.Join(entry.Name(), yearEntry.Name()))
+			}
+		}
+	}
+
+	return dirs, nil
+}
+
+// newPostDirPath returns where a new post directory goes, relative to the
+// posts directory: in a year directory when organize_by_year is set.
+func newPostDirPath(config Config, dirName string, created time.Time) string {
+	if !config.OrganizeByYear {
+		return dirName
+	}
+	year := newDateFormatter(config, false).In(created).Format("2006")
+	return filepath.Join(year, dirName)
+}
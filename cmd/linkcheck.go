@@ -0,0 +1,249 @@
+// cmd/linkcheck.go
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// linkCheckWorkers bounds the number of concurrent link requests.
+const linkCheckWorkers = 8
+
+var linkcheckCmd = &cobra.Command{
+	Use:   "linkcheck [post-id]",
+	Short: "Check a post for dead links",
+	Long: `Check the HTTP(S) links in a post's markdown.
+
+Each link is requested (HEAD, falling back to GET) with redirects
+followed, and any that fail or return an error status are reported with
+their line numbers. Relative links are listed separately since gists
+can't resolve them. Use --all to check every post.
+
+gblog exits with status 6 when any link is broken, so linkcheck can gate
+CI; relative links alone don't fail it.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+			return errNotInitialized()
+		}
+
+		all, _ := cmd.Flags().GetBool("all")
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+
+		var postDirs []string
+		switch {
+		case all && len(args) > 0:
+			return fmt.Errorf("--all can't be combined with a post ID")
+		case all:
+			posts, err := loadPosts()
+			if err != nil {
+				return err
+			}
+			for _, post := range posts {
+				postDirs = append(postDirs, filepath.Join("posts", post.Dir))
+			}
+		case len(args) == 1:
+			postDir, err := findPostDir(args[0])
+			if err != nil {
+				return err
+			}
+			postDirs = append(postDirs, postDir)
+		default:
+			return fmt.Errorf("requires a post ID (or --all)")
+		}
+
+		return linkcheckPosts(postDirs, timeout)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(linkcheckCmd)
+	linkcheckCmd.Flags().Bool("all", false, "Check every post")
+	linkcheckCmd.Flags().Duration("timeout", 10*time.Second, "Timeout for each link request")
+}
+
+// postLink is a link target found in a post file.
+type postLink struct {
+	file   string
+	line   int
+	target string
+}
+
+var (
+	markdownLinkPattern = regexp.MustCompile(`!?\[[^\]]*\]\(\s*<?([^)\s>]+)>?(?:\s+"[^"]*")?\s*\)`)
+	bareURLPattern      = regexp.MustCompile(`https?://[^\s<>()"'\]]+`)
+)
+
+func linkcheckPosts(postDirs []string, timeout time.Duration) error {
+	var remote, relative []postLink
+	for _, postDir := range postDirs {
+		files, err := getGistFiles(postDir)
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			if strings.ToLower(filepath.Ext(file)) != ".md" {
+				continue
+			}
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", file, err)
+			}
+			for _, link := range findLinks(file, string(content)) {
+				lower := strings.ToLower(link.target)
+				switch {
+				case strings.HasPrefix(lower, "http://"), strings.HasPrefix(lower, "https://"):
+					remote = append(remote, link)
+				case isRelativeLink(link.target):
+					relative = append(relative, link)
+				}
+			}
+		}
+	}
+
+	if len(remote) == 0 && len(relative) == 0 {
+		fmt.Println("No links found.")
+		return nil
+	}
+
+	// Request each distinct URL once
+	var urls []string
+	seen := map[string]bool{}
+	for _, link := range remote {
+		if !seen[link.target] {
+			seen[link.target] = true
+			urls = append(urls, link.target)
+		}
+	}
+
+	fmt.Printf("🔗 Checking %d link(s)...\n", len(urls))
+	results := checkLinks(urls, timeout)
+
+	broken := 0
+	for _, link := range remote {
+		if problem := results[link.target]; problem != "" {
+			if broken == 0 {
+				fmt.Println()
+			}
+			fmt.Printf("  ❌ %s:%d: %s → %s\n", link.file, link.line, link.target, problem)
+			broken++
+		}
+	}
+
+	if len(relative) > 0 {
+		fmt.Println("\nRelative links (gists can't resolve these):")
+		for _, link := range relative {
+			fmt.Printf("  ⚠️  %s:%d: %s\n", link.file, link.line, link.target)
+		}
+	}
+
+	fmt.Println()
+	if broken > 0 {
+		return withExitCode(ExitCheckFailed, fmt.Errorf("found %d broken link(s)", broken))
+	}
+	fmt.Printf("✅ All %d link(s) OK\n", len(urls))
+
+	return nil
+}
+
+// findLinks returns markdown links and bare URLs in content, skipping
+// fenced code blocks and inline code.
+func findLinks(file, content string) []postLink {
+	var links []postLink
+	inFence := false
+
+	for i, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		line = inlineCodePattern.ReplaceAllString(line, " ")
+		for _, match := range markdownLinkPattern.FindAllStringSubmatch(line, -1) {
+			links = append(links, postLink{file: file, line: i + 1, target: match[1]})
+		}
+
+		// Bare URLs outside of markdown links
+		line = markdownLinkPattern.ReplaceAllString(line, " ")
+		for _, url := range bareURLPattern.FindAllString(line, -1) {
+			url = strings.TrimRight(url, ".,;:!?")
+			links = append(links, postLink{file: file, line: i + 1, target: url})
+		}
+	}
+
+	return links
+}
+
+// checkLinks requests each URL with a bounded worker pool and returns a
+// problem description for every URL that failed.
+func checkLinks(urls []string, timeout time.Duration) map[string]string {
+	client := &http.Client{Timeout: timeout}
+	results := map[string]string{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	jobs := make(chan string)
+	for i := 0; i < linkCheckWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for url := range jobs {
+				problem := checkLink(client, url)
+				mu.Lock()
+				results[url] = problem
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, url := range urls {
+		jobs <- url
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// checkLink returns an empty string if url responds with a 2xx or 3xx
+// status. Servers that reject HEAD are retried with GET.
+func checkLink(client *http.Client, url string) string {
+	status, err := requestStatus(client, http.MethodHead, url)
+	if err != nil || status == http.StatusMethodNotAllowed || status == http.StatusForbidden || status == http.StatusNotImplemented {
+		status, err = requestStatus(client, http.MethodGet, url)
+	}
+	if err != nil {
+		return err.Error()
+	}
+	if status >= 400 {
+		return fmt.Sprintf("%d %s", status, http.StatusText(status))
+	}
+	return ""
+}
+
+func requestStatus(client *http.Client, method, url string) (int, error) {
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("User-Agent", "gblog-linkcheck")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,205 @@
+// cmd/lint.go
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// lintConfigPath holds per-rule overrides, e.g. {"rules": {"bare-url": false}}.
+const lintConfigPath = ".gblog/lint.json"
+
+var lintCmd = &cobra.Command{
+	Use:   "lint <post-id>",
+	Short: "Check a post's markdown for problems",
+	Long: `Check a post's markdown files for things that render badly on gists:
+
+  code-fence       unclosed code fences
+  bare-url         URLs that aren't written as links
+  image-alt        images without alt text
+  single-h1        more than one top-level heading
+  heading-jump     headings that skip a level (## to ####)
+
+Rules can be turned off in .gblog/lint.json:
+
+  {"rules": {"bare-url": false}}
+
+Problems are warnings unless --strict is given. 'gblog publish' runs the
+same checks first (skip them with --no-lint).`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		strict, _ := cmd.Flags().GetBool("strict")
+		postDir, err := findPostDir(args[0])
+		if err != nil {
+			return err
+		}
+		issues, err := lintPost(postDir)
+		if err != nil {
+			return err
+		}
+		if len(issues) == 0 {
+			fmt.Println("✅ No lint problems found")
+			return nil
+		}
+		printLintIssues(issues)
+		if strict {
+			return withExitCode(ExitCheckFailed, fmt.Errorf("%d lint problem(s) found", len(issues)))
+		}
+		return nil
+	},
+}
+
+// lintIssue is a problem found in a markdown file.
+type lintIssue struct {
+	file    string
+	line    int
+	rule    string
+	message string
+}
+
+// lintConfig is the contents of .gblog/lint.json.
+type lintConfig struct {
+	Rules map[string]bool `json:"rules"`
+}
+
+// lintRules lists the rule names, all enabled by default.
+var lintRules = []string{"code-fence", "bare-url", "image-alt", "single-h1", "heading-jump"}
+
+var (
+	missingAltPattern = regexp.MustCompile(`!\[\s*\]\(`)
+	autolinkPattern   = regexp.MustCompile(`<https?://[^>]*>`)
+	atxHeadingPattern = regexp.MustCompile(`^(#{1,6})(\s|$)`)
+)
+
+func init() {
+	rootCmd.AddCommand(lintCmd)
+	lintCmd.Flags().Bool("strict", false, "Exit with status 6 if any problem is found")
+}
+
+// loadLintRules returns which rules are enabled, applying lint.json.
+func loadLintRules() (map[string]bool, error) {
+	enabled := map[string]bool{}
+	for _, rule := range lintRules {
+		enabled[rule] = true
+	}
+
+	data, err := os.ReadFile(lintConfigPath)
+	if os.IsNotExist(err) {
+		return enabled, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", lintConfigPath, err)
+	}
+
+	var config lintConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", lintConfigPath, err)
+	}
+	for rule, on := range config.Rules {
+		if _, ok := enabled[rule]; !ok {
+			return nil, fmt.Errorf("unknown lint rule %q in %s (valid rules: %s)", rule, lintConfigPath, strings.Join(lintRules, ", "))
+		}
+		enabled[rule] = on
+	}
+
+	return enabled, nil
+}
+
+// lintPost lints every markdown file that would be published.
+func lintPost(postDir string) ([]lintIssue, error) {
+	enabled, err := loadLintRules()
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := getGistFiles(postDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []lintIssue
+	for _, file := range files {
+		if strings.ToLower(filepath.Ext(file)) != ".md" {
+			continue
+		}
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		issues = append(issues, lintMarkdown(file, string(content), enabled)...)
+	}
+
+	return issues, nil
+}
+
+// lintMarkdown checks content against the enabled rules.
+func lintMarkdown(file, content string, enabled map[string]bool) []lintIssue {
+	var issues []lintIssue
+	add := func(line int, rule, message string) {
+		if enabled[rule] {
+			issues = append(issues, lintIssue{file: file, line: line, rule: rule, message: message})
+		}
+	}
+
+	fenceLine := 0 // line of the open fence, 0 when outside one
+	h1Count := 0
+	lastLevel := 0
+	for i, line := range strings.Split(content, "\n") {
+		lineNo := i + 1
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			if fenceLine == 0 {
+				fenceLine = lineNo
+			} else {
+				fenceLine = 0
+			}
+			continue
+		}
+		if fenceLine != 0 {
+			continue
+		}
+
+		if m := atxHeadingPattern.FindStringSubmatch(trimmed); m != nil {
+			level := len(m[1])
+			if level == 1 {
+				h1Count++
+				if h1Count > 1 {
+					add(lineNo, "single-h1", "more than one top-level (#) heading")
+				}
+			}
+			if lastLevel > 0 && level > lastLevel+1 {
+				add(lineNo, "heading-jump", fmt.Sprintf("heading jumps from h%d to h%d", lastLevel, level))
+			}
+			lastLevel = level
+		}
+
+		line = inlineCodePattern.ReplaceAllString(line, " ")
+		if missingAltPattern.MatchString(line) {
+			add(lineNo, "image-alt", "image has no alt text")
+		}
+
+		line = markdownLinkPattern.ReplaceAllString(line, " ")
+		line = autolinkPattern.ReplaceAllString(line, " ")
+		for _, url := range bareURLPattern.FindAllString(line, -1) {
+			add(lineNo, "bare-url", fmt.Sprintf("bare URL %s; write it as [text](url) or <url>", strings.TrimRight(url, ".,;:!?")))
+		}
+	}
+
+	if fenceLine != 0 {
+		add(fenceLine, "code-fence", "code fence is never closed")
+	}
+
+	return issues
+}
+
+func printLintIssues(issues []lintIssue) {
+	for _, issue := range issues {
+		fmt.Printf("  ⚠️  %s:%d: %s (%s)\n", issue.file, issue.line, issue.message, issue.rule)
+	}
+}
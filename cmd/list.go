@@ -7,21 +7,27 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
+	"text/template"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/term"
 	"github.com/spf13/cobra"
 )
 
 var (
 	listTitleStyle = lipgloss.NewStyle().
 			Bold(true).
-			Foreground(lipgloss.Color("#7C3AED")).
+			Foreground(lipgloss.Color(defaultTheme["title"])).
 			Margin(1, 0)
 
-	publishedColor = lipgloss.NewStyle().Foreground(lipgloss.Color("#22C55E"))
-	draftColor     = lipgloss.NewStyle().Foreground(lipgloss.Color("#F59E0B"))
-	privateColor   = lipgloss.NewStyle().Foreground(lipgloss.Color("#EF4444"))
+	publishedColor = lipgloss.NewStyle().Foreground(lipgloss.Color(defaultTheme["published"]))
+	draftColor     = lipgloss.NewStyle().Foreground(lipgloss.Color(defaultTheme["draft"]))
+	privateColor   = lipgloss.NewStyle().Foreground(lipgloss.Color(defaultTheme["private"]))
+
+	listGroupStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(defaultTheme["accent"]))
 )
 
 type PostInfo struct {
@@ -35,90 +41,347 @@ var listCmd = &cobra.Command{
 	Long: `List all blog posts with their status and information.
 
 Shows post ID, title, status (draft/published), visibility (public/private),
-and creation date.`,
+and creation date.
+
+--modified narrows the list to what still needs publishing: drafts plus
+published posts whose files changed after their last publish.
+
+--with-size adds a Size column with the disk usage of each post's
+directory, and --sort size puts the largest posts first, which helps
+spot posts that are close to gist size limits.
+
+--json-lines prints one JSON object per post per line (NDJSON), written
+as each post is read so memory stays flat on large blogs. Posts come in
+directory order; filters apply, but sorting and paging don't.
+
+--group-by splits the table into sections by status (Published,
+Drafts), tag (a post appears under each of its tags), or month created
+(newest first). Posts keep the --sort order within each section.
+
+--format prints one line per post from a Go template instead of the
+table. The template sees a post's .Meta (ID, Title, Description, Public,
+Tags, Series, CreatedAt, PublishedAt, RemoteID, RemoteURL, Backend, ...)
+and .Dir:
+
+  gblog list --format '{{.Meta.ID}}: {{.Meta.Title}} ({{.Meta.RemoteURL}})'`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return listPosts()
+		idOnly, _ := cmd.Flags().GetBool("id-only")
+		sortBy, _ := cmd.Flags().GetString("sort")
+		grid, _ := cmd.Flags().GetBool("grid")
+		relative, _ := cmd.Flags().GetBool("relative")
+		long, _ := cmd.Flags().GetBool("long")
+		reverse, _ := cmd.Flags().GetBool("reverse")
+		limit, _ := cmd.Flags().GetInt("limit")
+		offset, _ := cmd.Flags().GetInt("offset")
+		format, _ := cmd.Flags().GetString("format")
+		withSize, _ := cmd.Flags().GetBool("with-size")
+		jsonLines, _ := cmd.Flags().GetBool("json-lines")
+		groupBy, _ := cmd.Flags().GetString("group-by")
+		if limit < 0 || offset < 0 {
+			return fmt.Errorf("--limit and --offset can't be negative")
+		}
+		filter, err := readFilterFlags(cmd)
+		if err != nil {
+			return err
+		}
+		filter.modified, _ = cmd.Flags().GetBool("modified")
+		filter.starred, _ = cmd.Flags().GetBool("starred")
+		if jsonLines {
+			return streamPostsJSON(filter)
+		}
+		return listPosts(listOptions{
+			idOnly:   idOnly,
+			grid:     grid,
+			relative: relative,
+			long:     long,
+			filter:   filter,
+			sortBy:   sortBy,
+			reverse:  reverse,
+			limit:    limit,
+			offset:   offset,
+			format:   format,
+			withSize: withSize,
+			groupBy:  groupBy,
+		})
 	},
 }
 
+// listOptions holds the filters and output settings for listPosts.
+type listOptions struct {
+	idOnly   bool
+	grid     bool
+	relative bool
+	long     bool
+	filter   postFilter
+	sortBy   string
+	reverse  bool
+	limit    int // 0 shows all posts
+	offset   int
+	format   string // text/template executed per post instead of the table
+	withSize bool   // add a column with each post's disk usage
+	groupBy  string // status, tag, or month; empty for one table
+}
+
+// postFilter selects posts by status, visibility, tag, and creation date.
+// Empty fields match everything.
+type postFilter struct {
+	status     string
+	visibility string
+	tag        string
+	dates      dateRange
+	modified   bool // only drafts and posts changed since their last publish
+	starred    bool // only published posts whose gist you starred
+}
+
 func init() {
 	rootCmd.AddCommand(listCmd)
+	listCmd.Flags().Bool("id-only", false, "Print only post IDs, one per line")
+	addFilterFlags(listCmd)
+	listCmd.Flags().Bool("modified", false, "Only show drafts and posts changed since they were published")
+	listCmd.Flags().Bool("starred", false, "Only show published posts whose gist you starred")
+	listCmd.Flags().String("sort", "id", "Sort posts by id, created, title, or size")
+	listCmd.Flags().Bool("reverse", false, "Reverse the sort order")
+	listCmd.Flags().Int("limit", 0, "Show at most N posts (0 for all)")
+	listCmd.Flags().Int("offset", 0, "Skip the first N posts")
+	listCmd.Flags().Bool("grid", false, "Show posts as a grid of cards")
+	listCmd.Flags().Bool("relative", false, "Show relative dates like '3 days ago'")
+	listCmd.Flags().BoolP("long", "l", false, "Show extra columns such as series")
+	listCmd.Flags().Bool("with-size", false, "Show the disk usage of each post")
+	listCmd.Flags().String("format", "", "Print each post with a Go template, e.g. '{{.Meta.ID}} {{.Meta.Title}}'")
+	listCmd.Flags().Bool("json-lines", false, "Stream one JSON object per post per line (NDJSON)")
+	listCmd.Flags().String("group-by", "", "Split the table into sections by status, tag, or month")
+	listCmd.MarkFlagsMutuallyExclusive("format", "id-only", "grid", "json-lines", "group-by")
+	listCmd.MarkFlagsMutuallyExclusive("json-lines", "sort", "reverse", "limit", "offset", "with-size")
 }
 
-func listPosts() error {
+func listPosts(opts listOptions) error {
 	// Check if gblog is initialized
 	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
-		return fmt.Errorf("gblog not initialized. Run 'gblog init' first")
+		return errNotInitialized()
 	}
 
-	// Read posts directory
-	postsDir := "posts"
-	if _, err := os.Stat(postsDir); os.IsNotExist(err) {
-		fmt.Println("No posts found. Create your first post with 'gblog new'")
-		return nil
+	config, err := loadConfig()
+	if err != nil {
+		return err
 	}
+	dates := newDateFormatter(config, opts.relative)
 
-	entries, err := os.ReadDir(postsDir)
-	if err != nil {
-		return fmt.Errorf("failed to read posts directory: %w", err)
+	switch opts.groupBy {
+	case "", "status", "tag", "month":
+	default:
+		return fmt.Errorf("invalid --group-by %q (use status, tag, or month)", opts.groupBy)
 	}
 
-	var posts []PostInfo
-	for _, entry := range entries {
-		if !entry.IsDir() {
-			continue
+	// Parse up front so a typo fails before any posts are read
+	var format *template.Template
+	if opts.format != "" {
+		format, err = template.New("format").Parse(opts.format)
+		if err != nil {
+			return fmt.Errorf("invalid --format template: %w", err)
 		}
+	}
 
-		metaPath := filepath.Join(postsDir, entry.Name(), ".meta.json")
-		metaData, err := os.ReadFile(metaPath)
-		if err != nil {
-			fmt.Printf("Warning: could not read metadata for %s: %v\n", entry.Name(), err)
-			continue
+	posts, err := loadPosts()
+	if err != nil {
+		return err
+	}
+
+	posts, err = filterPosts(posts, opts.filter)
+	if err != nil {
+		return err
+	}
+
+	if err := sortPosts(posts, opts.sortBy); err != nil {
+		return err
+	}
+	if opts.reverse {
+		for i, j := 0, len(posts)-1; i < j; i, j = i+1, j-1 {
+			posts[i], posts[j] = posts[j], posts[i]
 		}
+	}
 
-		var meta PostMeta
-		if err := json.Unmarshal(metaData, &meta); err != nil {
-			fmt.Printf("Warning: could not parse metadata for %s: %v\n", entry.Name(), err)
-			continue
+	// Page through the sorted posts; stats still cover every match
+	matched := posts
+	posts, remaining := pagePosts(posts, opts.offset, opts.limit)
+
+	if opts.idOnly {
+		for _, post := range posts {
+			fmt.Println(post.Meta.ID)
 		}
+		return nil
+	}
 
-		posts = append(posts, PostInfo{
-			Meta: meta,
-			Dir:  entry.Name(),
-		})
+	if format != nil {
+		for _, post := range posts {
+			if err := format.Execute(os.Stdout, post); err != nil {
+				return fmt.Errorf("failed to format post %s: %w", post.Meta.ID, err)
+			}
+			fmt.Println()
+		}
+		return nil
 	}
 
-	if len(posts) == 0 {
+	if len(matched) == 0 {
+		if opts.filter != (postFilter{}) {
+			fmt.Println("No posts match")
+			return nil
+		}
 		fmt.Println("No posts found. Create your first post with 'gblog new'")
 		return nil
 	}
-
-	// Sort posts by ID (newest first)
-	sort.Slice(posts, func(i, j int) bool {
-		return posts[i].Meta.ID > posts[j].Meta.ID
-	})
+	if len(posts) == 0 {
+		fmt.Printf("No posts at offset %d (%d total)\n", opts.offset, len(matched))
+		return nil
+	}
 
 	// Display header
 	fmt.Println(listTitleStyle.Render("📝 Blog Posts"))
 	fmt.Println()
 
+	columns := tableOptions{series: opts.long, size: opts.withSize}
+	switch {
+	case opts.grid:
+		fmt.Println(renderPostGrid(posts, dates, terminalWidth()))
+	case opts.groupBy != "":
+		for i, group := range groupPosts(posts, opts.groupBy, dates) {
+			if i > 0 {
+				fmt.Println()
+			}
+			fmt.Println(listGroupStyle.Render(fmt.Sprintf("%s (%d)", group.label, len(group.posts))))
+			printPostTable(group.posts, dates, columns)
+		}
+	default:
+		printPostTable(posts, dates, columns)
+	}
+
+	if remaining > 0 {
+		fmt.Printf("... and %d more (use --limit 0 for all)\n", remaining)
+	}
+
+	fmt.Println()
+	printListStats(matched)
+
+	return nil
+}
+
+// pagePosts returns the posts after skipping offset and keeping at most
+// limit (0 for no limit), along with how many posts follow the page.
+func pagePosts(posts []PostInfo, offset, limit int) ([]PostInfo, int) {
+	if offset >= len(posts) {
+		return nil, 0
+	}
+	posts = posts[offset:]
+	if limit == 0 || limit >= len(posts) {
+		return posts, 0
+	}
+	return posts[:limit], len(posts) - limit
+}
+
+// postGroup is one section of 'gblog list --group-by'.
+type postGroup struct {
+	label string
+	posts []PostInfo
+}
+
+// groupPosts splits posts into labeled sections by status, tag, or
+// month created, keeping their order within each section. Sections are
+// Published then Drafts, tags alphabetically then Untagged, or months
+// newest first in the configured timezone. Empty sections are left out.
+func groupPosts(posts []PostInfo, groupBy string, dates dateFormatter) []postGroup {
+	var labels []string
+	byLabel := map[string][]PostInfo{}
+	add := func(label string, post PostInfo) {
+		if _, ok := byLabel[label]; !ok {
+			labels = append(labels, label)
+		}
+		byLabel[label] = append(byLabel[label], post)
+	}
+
+	months := map[string]time.Time{}
+	for _, post := range posts {
+		switch groupBy {
+		case "status":
+			if post.Meta.RemoteID != "" {
+				add("Published", post)
+			} else {
+				add("Drafts", post)
+			}
+		case "tag":
+			if len(post.Meta.Tags) == 0 {
+				add("Untagged", post)
+			}
+			for _, tag := range post.Meta.Tags {
+				add(tag, post)
+			}
+		case "month":
+			created := dates.In(post.Meta.CreatedAt)
+			label := created.Format("January 2006")
+			months[label] = created
+			add(label, post)
+		}
+	}
+
+	// Drafts and Untagged always come last
+	last := map[string]string{"status": "Drafts", "tag": "Untagged"}[groupBy]
+	sort.SliceStable(labels, func(i, j int) bool {
+		a, b := labels[i], labels[j]
+		if a == last || b == last {
+			return b == last && a != last
+		}
+		switch groupBy {
+		case "tag":
+			return strings.ToLower(a) < strings.ToLower(b)
+		case "month":
+			ta, tb := months[a], months[b]
+			return ta.Year() > tb.Year() || (ta.Year() == tb.Year() && ta.Month() > tb.Month())
+		}
+		return false
+	})
+
+	groups := make([]postGroup, 0, len(labels))
+	for _, label := range labels {
+		groups = append(groups, postGroup{label: label, posts: byLabel[label]})
+	}
+	return groups
+}
+
+// tableOptions selects the optional columns of printPostTable.
+type tableOptions struct {
+	series bool
+	size   bool
+}
+
+// printPostTable prints posts as a table with one row per post.
+func printPostTable(posts []PostInfo, dates dateFormatter, columns tableOptions) {
+	// Size the date column to fit custom formats
+	createdWidth := 12
+	for _, post := range posts {
+		if w := len(dates.Format(post.Meta.CreatedAt)) + 1; w > createdWidth {
+			createdWidth = w
+		}
+	}
+
 	// Simple table without complex formatting
-	fmt.Printf("%-4s %-35s %-12s %-10s %-12s %s\n",
-		"ID", "Title", "Status", "Visibility", "Created", "Gist URL")
+	extraHeader := ""
+	if columns.series {
+		extraHeader += fmt.Sprintf("%-24s ", "Series")
+	}
+	if columns.size {
+		extraHeader += fmt.Sprintf("%-10s ", "Size")
+	}
+	fmt.Printf("%-4s %-35s %-12s %-10s %-*s %s%s\n",
+		"ID", "Title", "Status", "Visibility", createdWidth, "Created", extraHeader, "Gist URL")
 	fmt.Println(strings.Repeat("-", 120))
 
 	// Table rows
 	for _, post := range posts {
 		// Truncate title if too long
-		title := post.Meta.Title
-		if len(title) > 33 {
-			title = title[:30] + "..."
-		}
+		title := truncateText(post.Meta.Title, 33)
 
 		// Status
 		status := "Draft"
 		statusColor := draftColor
-		if post.Meta.GistID != "" {
+		if post.Meta.RemoteID != "" {
 			status = "Published"
 			statusColor = publishedColor
 		}
@@ -132,34 +395,50 @@ func listPosts() error {
 		}
 
 		// Created date
-		created := post.Meta.CreatedAt.Format("2006-01-02")
+		created := dates.Format(post.Meta.CreatedAt)
 
 		// Gist URL
 		gistURL := "-"
-		if post.Meta.GistURL != "" {
-			gistURL = post.Meta.GistURL
-			if len(gistURL) > 45 {
-				gistURL = gistURL[:42] + "..."
+		if post.Meta.RemoteURL != "" {
+			gistURL = truncateText(post.Meta.RemoteURL, 45)
+		}
+
+		// Optional columns
+		extra := ""
+		if columns.series {
+			series := "-"
+			if post.Meta.Series != "" {
+				series = truncateText(fmt.Sprintf("%s #%d", post.Meta.Series, post.Meta.SeriesOrder), 23)
 			}
+			extra += fmt.Sprintf("%-24s ", series)
+		}
+		if columns.size {
+			size := "?"
+			if bytes, err := postSize(post.Dir); err == nil {
+				size = formatSize(bytes)
+			}
+			extra += fmt.Sprintf("%-10s ", size)
 		}
 
 		// Print row with colors
-		fmt.Printf("%-4s %-35s %-12s %-10s %-12s %s\n",
+		fmt.Printf("%-4s %-35s %-12s %-10s %-*s %s%s\n",
 			post.Meta.ID,
 			title,
 			statusColor.Render(status),
 			visibilityColor.Render(visibility),
+			createdWidth,
 			created,
+			extra,
 			gistURL)
 	}
+}
 
-	fmt.Println()
-
-	// Stats
+// printListStats prints the summary line shown below the post list.
+func printListStats(posts []PostInfo) {
 	published := 0
 	private := 0
 	for _, post := range posts {
-		if post.Meta.GistID != "" {
+		if post.Meta.RemoteID != "" {
 			published++
 		}
 		if !post.Meta.Public {
@@ -169,6 +448,331 @@ func listPosts() error {
 
 	fmt.Printf("Total: %d | Published: %d | Drafts: %d | Private: %d\n",
 		len(posts), published, len(posts)-published, private)
+}
+
+// truncateText shortens s to at most max characters, ending it with
+// "..." when cut. It counts runes so multibyte text isn't split.
+func truncateText(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max-3]) + "..."
+}
+
+// renderPostGrid lays posts out as bordered cards in as many columns as
+// fit within width.
+func renderPostGrid(posts []PostInfo, dates dateFormatter, width int) string {
+	const cardWidth = 32
+
+	cardStyle := inputStyle.Width(cardWidth)
+
+	var cards []string
+	for _, post := range posts {
+		title := truncateText(post.Meta.Title, cardWidth-2)
+
+		status := draftColor.Render("● Draft")
+		if post.Meta.RemoteID != "" {
+			status = publishedColor.Render("● Published")
+		}
+
+		visibility := "Public"
+		if !post.Meta.Public {
+			visibility = privateColor.Render("Private")
+		}
+
+		card := lipgloss.JoinVertical(lipgloss.Left,
+			lipgloss.NewStyle().Bold(true).Render(post.Meta.ID),
+			title,
+			"",
+			status,
+			visibility,
+			dates.Format(post.Meta.CreatedAt),
+		)
+		cards = append(cards, cardStyle.Render(card))
+	}
+
+	// Cards are separated by a single space
+	columns := 1
+	if len(cards) > 0 {
+		columns = (width + 1) / (lipgloss.Width(cards[0]) + 1)
+		if columns < 1 {
+			columns = 1
+		}
+	}
+
+	var rows []string
+	for i := 0; i < len(cards); i += columns {
+		end := i + columns
+		if end > len(cards) {
+			end = len(cards)
+		}
+
+		row := make([]string, 0, 2*(end-i))
+		for j, card := range cards[i:end] {
+			if j > 0 {
+				row = append(row, " ")
+			}
+			row = append(row, card)
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, row...))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// terminalWidth returns the width of stdout, falling back to $COLUMNS or 80.
+func terminalWidth() int {
+	if width, _, err := term.GetSize(os.Stdout.Fd()); err == nil && width > 0 {
+		return width
+	}
+	if width, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && width > 0 {
+		return width
+	}
+	return 80
+}
+
+// listJSONPost is one line of 'list --json-lines' output.
+type listJSONPost struct {
+	PostMeta
+	Dir string `json:"dir"`
+}
+
+// streamPostsJSON writes each post matching filter to stdout as a line of
+// JSON as soon as it is read.
+func streamPostsJSON(filter postFilter) error {
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+
+	match, done, err := postMatcher(filter)
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	encoder := json.NewEncoder(os.Stdout)
+	return walkPosts(func(post PostInfo) error {
+		ok, err := match(post)
+		if err != nil || !ok {
+			return err
+		}
+		return encoder.Encode(listJSONPost{PostMeta: post.Meta, Dir: post.Dir})
+	})
+}
+
+// loadPosts reads the metadata of every post in the posts directory.
+// Posts with unreadable metadata are skipped with a warning on stderr.
+func loadPosts() ([]PostInfo, error) {
+	var posts []PostInfo
+	err := walkPosts(func(post PostInfo) error {
+		posts = append(posts, post)
+		return nil
+	})
+	return posts, err
+}
+
+// walkPosts calls fn with each post in the posts directory, in directory
+// order, as its metadata is read. Posts with unreadable metadata are
+// skipped with a warning on stderr. An error from fn stops the walk.
+func walkPosts(fn func(PostInfo) error) error {
+	postsDir := "posts"
+	if _, err := os.Stat(postsDir); os.IsNotExist(err) {
+		return nil
+	}
+
+	dirs, err := listPostDirs(postsDir)
+	if err != nil {
+		return fmt.Errorf("failed to read posts directory: %w", err)
+	}
+
+	for _, dir := range dirs {
+		metaPath := filepath.Join(postsDir, dir, ".meta.json")
+		metaData, err := os.ReadFile(metaPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not read metadata for %s: %v\n", dir, err)
+			continue
+		}
+
+		var meta PostMeta
+		if err := json.Unmarshal(metaData, &meta); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not parse metadata for %s: %v\n", dir, err)
+			continue
+		}
+
+		if err := validatePostMeta(meta, filepath.Join(postsDir, dir)); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+
+		if err := fn(PostInfo{Meta: meta, Dir: dir}); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
+
+// addFilterFlags registers the --status, --visibility, and --tag flags.
+func addFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().String("status", "", "Only include posts with this status (draft, published)")
+	cmd.Flags().String("visibility", "", "Only include posts with this visibility (public, private)")
+	cmd.Flags().String("tag", "", "Only include posts with this tag")
+	cmd.RegisterFlagCompletionFunc("tag", completeTags)
+	addDateRangeFlags(cmd)
+}
+
+// readFilterFlags builds a postFilter from the flags added by addFilterFlags.
+func readFilterFlags(cmd *cobra.Command) (postFilter, error) {
+	status, _ := cmd.Flags().GetString("status")
+	visibility, _ := cmd.Flags().GetString("visibility")
+	tag, _ := cmd.Flags().GetString("tag")
+
+	dates, err := readDateRangeFlags(cmd)
+	if err != nil {
+		return postFilter{}, err
+	}
+
+	return postFilter{
+		status:     status,
+		visibility: visibility,
+		tag:        tag,
+		dates:      dates,
+	}, nil
+}
+
+// filterPosts keeps the posts matching filter.
+func filterPosts(posts []PostInfo, filter postFilter) ([]PostInfo, error) {
+	match, done, err := postMatcher(filter)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	var filtered []PostInfo
+	for _, post := range posts {
+		ok, err := match(post)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			filtered = append(filtered, post)
+		}
+	}
+
+	return filtered, nil
+}
+
+// postMatcher validates filter and returns a func reporting whether a
+// post matches it. Call done once matching is finished.
+func postMatcher(filter postFilter) (match func(PostInfo) (bool, error), done func(), err error) {
+	status := strings.ToLower(filter.status)
+	visibility := strings.ToLower(filter.visibility)
+	tag := normalizeTag(filter.tag)
+
+	switch status {
+	case "", "draft", "published":
+	default:
+		return nil, nil, fmt.Errorf("invalid status %q (use draft or published)", status)
+	}
+
+	switch visibility {
+	case "", "public", "private":
+	default:
+		return nil, nil, fmt.Errorf("invalid visibility %q (use public or private)", visibility)
+	}
+
+	done = func() {}
+	var stars map[string]starStatus
+	if filter.starred {
+		if err := checkGHAuth(); err != nil {
+			return nil, nil, err
+		}
+		stars = loadStarCache()
+		done = func() { saveStarCache(stars) }
+	}
+
+	match = func(post PostInfo) (bool, error) {
+		published := post.Meta.RemoteID != ""
+		if status == "draft" && published || status == "published" && !published {
+			return false, nil
+		}
+		if visibility == "public" && !post.Meta.Public || visibility == "private" && post.Meta.Public {
+			return false, nil
+		}
+		if tag != "" && !hasTag(post.Meta, tag) {
+			return false, nil
+		}
+		if !filter.dates.Contains(post.Meta.CreatedAt) {
+			return false, nil
+		}
+		if filter.modified && published {
+			changed, err := postModified(filepath.Join("posts", post.Dir), post.Meta)
+			if err != nil {
+				return false, fmt.Errorf("failed to check post %s: %w", post.Meta.ID, err)
+			}
+			if !changed {
+				return false, nil
+			}
+		}
+		if filter.starred {
+			if !published {
+				return false, nil
+			}
+			starred, err := isGistStarred(post.Meta.RemoteID, stars)
+			if err != nil {
+				return false, fmt.Errorf("failed to check star for post %s: %w", post.Meta.ID, err)
+			}
+			if !starred {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+
+	return match, done, nil
+}
+
+// sortPosts orders posts in place by id or created (newest first) or by title.
+func sortPosts(posts []PostInfo, sortBy string) error {
+	switch strings.ToLower(sortBy) {
+	case "", "id":
+		sort.Slice(posts, func(i, j int) bool {
+			return posts[i].Meta.ID > posts[j].Meta.ID
+		})
+	case "created":
+		sort.Slice(posts, func(i, j int) bool {
+			return posts[i].Meta.CreatedAt.After(posts[j].Meta.CreatedAt)
+		})
+	case "title":
+		sort.Slice(posts, func(i, j int) bool {
+			return strings.ToLower(posts[i].Meta.Title) < strings.ToLower(posts[j].Meta.Title)
+		})
+	case "size":
+		sizes := make(map[string]int64, len(posts))
+		for _, post := range posts {
+			sizes[post.Dir], _ = postSize(post.Dir)
+		}
+		sort.SliceStable(posts, func(i, j int) bool {
+			return sizes[posts[i].Dir] > sizes[posts[j].Dir]
+		})
+	default:
+		return fmt.Errorf("invalid sort %q (use id, created, title, or size)", sortBy)
+	}
+	return nil
+}
+
+// postSize returns the total size in bytes of the files in a post
+// directory (relative to posts/).
+func postSize(dir string) (int64, error) {
+	var total int64
+	err := filepath.Walk(filepath.Join("posts", dir), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			total += info.Size()
+		}
+		return nil
+	})
+	return total, err
+}
@@ -0,0 +1,76 @@
+// cmd/log.go
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/spf13/cobra"
+)
+
+// logger is set by --log-json; when nil, progress is printed for humans.
+var (
+	logJSON bool
+	logger  *slog.Logger
+)
+
+// setupLogging creates the JSON logger for cmd when --log-json is set.
+// Records go to stderr so they don't mix with command output.
+func setupLogging(cmd *cobra.Command) {
+	if !logJSON {
+		return
+	}
+	logger = slog.New(slog.NewJSONHandler(os.Stderr, nil)).With("command", cmd.CommandPath())
+}
+
+// logInfo prints a progress message. With --log-json it is written as a
+// structured record with the given key/value attributes instead.
+func logInfo(msg string, attrs ...any) {
+	if logger == nil {
+		fmt.Println(msg)
+		return
+	}
+	logger.Info(logMessage(msg), attrs...)
+}
+
+// logWarn is logInfo for problems that don't stop the command.
+func logWarn(msg string, attrs ...any) {
+	if logger == nil {
+		fmt.Println(msg)
+		return
+	}
+	logger.Warn(logMessage(msg), attrs...)
+}
+
+// logWarnStderr is logWarn for messages that humans see on stderr.
+func logWarnStderr(msg string, attrs ...any) {
+	if logger == nil {
+		fmt.Fprintln(os.Stderr, msg)
+		return
+	}
+	logger.Warn(logMessage(msg), attrs...)
+}
+
+// logCommandResult records how a command finished and how long it took.
+func logCommandResult(err error, elapsed time.Duration) {
+	if logger == nil {
+		return
+	}
+	duration := elapsed.Round(time.Millisecond).String()
+	if err != nil {
+		logger.Error("command failed", "error", err.Error(), "duration", duration)
+		return
+	}
+	logger.Info("command finished", "duration", duration)
+}
+
+// logMessage strips the leading emoji and indentation used in human output.
+func logMessage(msg string) string {
+	return strings.TrimSpace(strings.TrimLeftFunc(msg, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '\''
+	}))
+}
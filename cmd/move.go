@@ -0,0 +1,159 @@
+// cmd/move.go
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var moveCmd = &cobra.Command{
+	Use:   "move <post-id> <new-id>",
+	Short: "Renumber a post",
+	Long: `Renumber a post by changing its ID.
+
+This renames the post directory, updates the ID in .meta.json and any
+.gitignore entry, and bumps next_id in the config if needed. Inside a
+git repository the directory is moved with 'git mv' to keep history.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return movePost(args[0], args[1])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(moveCmd)
+}
+
+func movePost(postID, newID string) error {
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	newID, err = normalizePostID(newID)
+	if err != nil {
+		return err
+	}
+
+	postDir, err := findPostDir(postID)
+	if err != nil {
+		return err
+	}
+
+	meta, err := loadPostMeta(postDir)
+	if err != nil {
+		return err
+	}
+
+	if meta.ID == newID {
+		logInfo(fmt.Sprintf("Post %s already has ID %s", postID, newID), "post_id", postID)
+		return nil
+	}
+
+	if existing, err := findPostDir(newID); err == nil {
+		return fmt.Errorf("post ID %s is already used by %s", newID, existing)
+	}
+
+	// Keep the slug, swap the ID prefix
+	_, slug, _ := strings.Cut(filepath.Base(postDir), "-")
+	newDir := filepath.Join(filepath.Dir(postDir), fmt.Sprintf("%s-%s", newID, slug))
+
+	if err := renamePostDir(postDir, newDir); err != nil {
+		return err
+	}
+
+	oldID := meta.ID
+	meta.ID = newID
+	if err := savePostMeta(newDir, meta); err != nil {
+		return err
+	}
+
+	if err := replaceGitignoreEntry(postDir, newDir); err != nil {
+		logWarn(fmt.Sprintf("Warning: could not update .gitignore: %v", err))
+	}
+
+	// Never hand out the new ID again
+	if id, _ := strconv.Atoi(newID); id >= config.NextID {
+		config.NextID = id + 1
+		if err := saveConfig(config); err != nil {
+			return err
+		}
+	}
+
+	recordHistory("move", newID, meta.RemoteID, fmt.Sprintf("%s → %s", oldID, newID))
+
+	logInfo(fmt.Sprintf("✅ Moved %s → %s", postDir, newDir))
+	return nil
+}
+
+// normalizePostID validates a numeric post ID and zero-pads it.
+func normalizePostID(id string) (string, error) {
+	n, err := strconv.Atoi(strings.TrimSpace(id))
+	if err != nil || n <= 0 {
+		return "", fmt.Errorf("invalid post ID %q: must be a positive number", id)
+	}
+	return fmt.Sprintf("%04d", n), nil
+}
+
+// renamePostDir moves a post directory (or file), using 'git mv' when the
+// path is tracked by git so history is preserved.
+func renamePostDir(oldPath, newPath string) error {
+	if _, err := os.Stat(newPath); err == nil {
+		return fmt.Errorf("%s already exists", newPath)
+	}
+
+	if isGitTracked(oldPath) {
+		cmd := exec.Command("git", "mv", oldPath, newPath)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("git mv failed: %s", strings.TrimSpace(string(output)))
+		}
+		return nil
+	}
+
+	if err := os.Rename(oldPath, newPath); err != nil {
+		return fmt.Errorf("failed to rename %s: %w", oldPath, err)
+	}
+	return nil
+}
+
+// isGitTracked reports whether path has files tracked by git.
+func isGitTracked(path string) bool {
+	output, err := exec.Command("git", "ls-files", "--", path).Output()
+	return err == nil && strings.TrimSpace(string(output)) != ""
+}
+
+// replaceGitignoreEntry rewrites a private post's .gitignore entry after
+// its directory moved. It is a no-op when the old entry isn't present.
+func replaceGitignoreEntry(oldDir, newDir string) error {
+	data, err := os.ReadFile(".gitignore")
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	oldEntry := filepath.ToSlash(oldDir) + "/"
+	newEntry := filepath.ToSlash(newDir) + "/"
+
+	lines := strings.Split(string(data), "\n")
+	changed := false
+	for i, line := range lines {
+		if strings.TrimSpace(line) == oldEntry {
+			lines[i] = newEntry
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return os.WriteFile(".gitignore", []byte(strings.Join(lines, "\n")), 0644)
+}
@@ -4,9 +4,11 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,41 +19,103 @@ import (
 )
 
 type PostMeta struct {
-	ID          string    `json:"id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	Public      bool      `json:"public"`
-	CreatedAt   time.Time `json:"created_at"`
-	GistID      string    `json:"gist_id,omitempty"`
-	GistURL     string    `json:"gist_url,omitempty"`
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Public      bool       `json:"public"`
+	Tags        []string   `json:"tags,omitempty"`
+	Series      string     `json:"series,omitempty"`
+	SeriesOrder int        `json:"series_order,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	RemoteID    string     `json:"remote_id,omitempty"`
+	RemoteURL   string     `json:"remote_url,omitempty"`
+	Backend     string     `json:"backend,omitempty"`
+	Anonymous   bool       `json:"anonymous,omitempty"`
+
+	// RemoteRevision is the remote's revision SHA after gblog last wrote
+	// it, used to detect edits made on the remote since.
+	RemoteRevision string `json:"remote_revision,omitempty"`
+
+	// ContentHash identifies the files last published, to catch the same
+	// content going out as two different posts.
+	ContentHash string `json:"content_hash,omitempty"`
+
+	// UpstreamID is the gist this post's gist was forked from, if any.
+	UpstreamID string `json:"upstream_id,omitempty"`
 }
 
+// UnmarshalJSON reads post metadata, accepting the gist_id, gist_url, and
+// gist_revision keys written before the remote fields were generalized.
+func (m *PostMeta) UnmarshalJSON(data []byte) error {
+	type plainMeta PostMeta
+	legacy := struct {
+		*plainMeta
+		GistID       string `json:"gist_id"`
+		GistURL      string `json:"gist_url"`
+		GistRevision string `json:"gist_revision"`
+	}{plainMeta: (*plainMeta)(m)}
+
+	if err := json.Unmarshal(data, &legacy); err != nil {
+		return err
+	}
+
+	if m.RemoteID == "" {
+		m.RemoteID = legacy.GistID
+	}
+	if m.RemoteURL == "" {
+		m.RemoteURL = legacy.GistURL
+	}
+	if m.RemoteRevision == "" {
+		m.RemoteRevision = legacy.GistRevision
+	}
+	// Older versions wrote unset times as the zero time
+	if m.UpdatedAt != nil && m.UpdatedAt.IsZero() {
+		m.UpdatedAt = nil
+	}
+	if m.PublishedAt != nil && m.PublishedAt.IsZero() {
+		m.PublishedAt = nil
+	}
+	return nil
+}
+
+// GistID is RemoteID under its old name, so 'list --format' templates
+// written against {{.Meta.GistID}} keep working.
+func (m PostMeta) GistID() string { return m.RemoteID }
+
+// GistURL is RemoteURL under its old name, for the same reason.
+func (m PostMeta) GistURL() string { return m.RemoteURL }
+
 type newPostModel struct {
-	step        int
-	title       textinput.Model
-	description textinput.Model
-	isPublic    bool
-	err         error
-	quitting    bool
+	step         int
+	title        textinput.Model
+	description  textinput.Model
+	filename     textinput.Model
+	skipFilename bool
+	skipPublic   bool // visibility was given by flag
+	isPublic     bool
+	err          error
+	quitting     bool
 }
 
 var (
 	titleStyle = lipgloss.NewStyle().
 			Bold(true).
-			Foreground(lipgloss.Color("#7C3AED")).
+			Foreground(lipgloss.Color(defaultTheme["title"])).
 			Margin(1, 0)
 
 	inputStyle = lipgloss.NewStyle().
 			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("#7C3AED")).
+			BorderForeground(lipgloss.Color(defaultTheme["accent"])).
 			Padding(0, 1)
 
 	helpStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#666666")).
+			Foreground(lipgloss.Color(defaultTheme["help"])).
 			Margin(1, 0)
 
 	errorStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FF0000")).
+			Foreground(lipgloss.Color(defaultTheme["error"])).
 			Bold(true)
 )
 
@@ -61,20 +125,118 @@ var newCmd = &cobra.Command{
 	Long: `Create a new blog post with an interactive CLI.
 
 This will prompt you for the post title, description, and visibility,
-then create a new directory with the post files.`,
+then create a new directory with the post files. --public or --private
+answers the visibility question up front (and overrides default_public
+for --from-file).
+
+If another post already has the same title, you're asked before a
+duplicate is created; --allow-duplicate skips the question.
+
+With --edit, the new post is opened in $EDITOR right away; add --publish
+to publish it as soon as the editor exits.
+
+--id gives the post a specific ID, for example to keep the numbering of
+posts migrated from elsewhere. The ID must be free; next_id moves past it
+if needed so later posts don't collide.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return runNewPost()
+		fromFile, _ := cmd.Flags().GetString("from-file")
+		filename, _ := cmd.Flags().GetString("filename")
+		tags, _ := cmd.Flags().GetStringSlice("tag")
+		series, _ := cmd.Flags().GetString("series")
+		seriesOrder, _ := cmd.Flags().GetInt("series-order")
+		private, _ := cmd.Flags().GetBool("private")
+		allowDuplicate, _ := cmd.Flags().GetBool("allow-duplicate")
+		id, _ := cmd.Flags().GetInt("id")
+		if cmd.Flags().Changed("id") {
+			if id <= 0 {
+				return fmt.Errorf("--id must be a positive number")
+			}
+			// Fail before asking any questions
+			if existing, err := findPostDir(fmt.Sprintf("%04d", id)); err == nil {
+				return fmt.Errorf("post ID %04d already exists (%s)", id, existing)
+			}
+		}
+		base := postSpec{
+			ID:          id,
+			Tags:        tags,
+			Series:      series,
+			SeriesOrder: seriesOrder,
+			Filename:    filename,
+			Public:      !private,
+			PublicSet:   cmd.Flags().Changed("public") || cmd.Flags().Changed("private"),
+
+			AllowDuplicate: allowDuplicate,
+		}
+
+		var postID string
+		var err error
+		if fromFile != "" {
+			title, _ := cmd.Flags().GetString("title")
+			postID, err = runNewPostFromFile(fromFile, title, base)
+		} else {
+			postID, err = runNewPost(base)
+		}
+		if err != nil || postID == "" {
+			return err
+		}
+
+		// Go straight to writing (and publishing) the new post
+		edit, _ := cmd.Flags().GetBool("edit")
+		publish, _ := cmd.Flags().GetBool("publish")
+		if edit || publish {
+			fmt.Println()
+			return editPostAndWait(postID, "", publish)
+		}
+		return nil
 	},
 }
 
+// postSpec describes a post to be created by createPost.
+type postSpec struct {
+	ID          int // post number; 0 takes next_id from the config
+	Title       string
+	Description string
+	Public      bool
+	PublicSet   bool // Public came from a flag rather than the config default
+	Tags        []string
+	Series      string
+	SeriesOrder int    // position in Series; 0 appends to the end
+	Filename    string // primary file name; empty uses <slug>.md
+	Content     string // initial content; empty uses the default template
+
+	AllowDuplicate bool // create the post even if another has the same title
+	Quiet          bool // skip the summary printed after creating the post
+}
+
 func init() {
 	rootCmd.AddCommand(newCmd)
+	newCmd.Flags().String("from-file", "", "Create the post from an existing markdown file ('-' for stdin)")
+	newCmd.Flags().String("title", "", "Post title (defaults to the first '# ' heading with --from-file)")
+	newCmd.Flags().String("filename", "", "Name of the primary file, e.g. snippet.py (default <slug>.md)")
+	newCmd.Flags().StringSlice("tag", nil, "Tag the post (repeatable or comma-separated)")
+	newCmd.RegisterFlagCompletionFunc("tag", completeTags)
+	newCmd.Flags().String("series", "", "Add the post to a series")
+	newCmd.Flags().Int("series-order", 0, "Position within the series (default: next)")
+	newCmd.Flags().Bool("public", false, "Make the post public without asking")
+	newCmd.Flags().Bool("private", false, "Make the post private without asking")
+	newCmd.MarkFlagsMutuallyExclusive("public", "private")
+	newCmd.Flags().Int("id", 0, "Use this post ID instead of the next free one")
+	newCmd.Flags().Bool("allow-duplicate", false, "Create the post even if another post has the same title")
+	newCmd.Flags().Bool("edit", false, "Open the new post in $EDITOR")
+	newCmd.Flags().Bool("publish", false, "Publish the post after the editor exits (implies --edit)")
 }
 
-func runNewPost() error {
+// runNewPost runs the interactive TUI; base carries the flag-driven fields.
+// It returns the new post's ID, or an empty ID if cancelled.
+func runNewPost(base postSpec) (string, error) {
 	// Check if gblog is initialized
 	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
-		return fmt.Errorf("gblog not initialized. Run 'gblog init' first")
+		return "", errNotInitialized()
+	}
+
+	config, err := loadConfig()
+	if err != nil {
+		return "", err
 	}
 
 	m := newPostModel{
@@ -94,20 +256,90 @@ func runNewPost() error {
 	m.description.CharLimit = 200
 	m.description.Width = 50
 
-	m.isPublic = true // default
+	// Initialize filename input (placeholder is set from the title)
+	m.filename = textinput.New()
+	m.filename.CharLimit = 100
+	m.filename.Width = 50
+	if base.Filename != "" {
+		if err := validatePostFilename(base.Filename); err != nil {
+			return "", err
+		}
+		m.filename.SetValue(base.Filename)
+		m.skipFilename = true
+	}
+
+	m.isPublic = config.DefaultPublic
+	if base.PublicSet {
+		m.isPublic = base.Public
+		m.skipPublic = true
+	}
 
 	p := tea.NewProgram(m)
 	finalModel, err := p.Run()
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	if finalModel.(newPostModel).quitting {
 		fmt.Println("Cancelled.")
-		return nil
+		return "", nil
+	}
+
+	final := finalModel.(newPostModel)
+	spec := base
+	spec.Title = final.title.Value()
+	spec.Description = final.description.Value()
+	spec.Public = final.isPublic
+	spec.Filename = final.filename.Value()
+	return createPost(spec)
+}
+
+func runNewPostFromFile(path, title string, base postSpec) (string, error) {
+	// Check if gblog is initialized
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return "", errNotInitialized()
+	}
+
+	config, err := loadConfig()
+	if err != nil {
+		return "", err
+	}
+
+	var content []byte
+	if path == "-" {
+		content, err = io.ReadAll(os.Stdin)
+	} else {
+		content, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read markdown: %w", err)
+	}
+
+	if strings.TrimSpace(title) == "" {
+		title = markdownTitle(string(content))
+	}
+	if strings.TrimSpace(title) == "" {
+		return "", fmt.Errorf("could not find a '# ' heading; specify one with --title")
 	}
 
-	return createPost(finalModel.(newPostModel))
+	spec := base
+	spec.Title = strings.TrimSpace(title)
+	if !spec.PublicSet {
+		spec.Public = config.DefaultPublic
+	}
+	spec.Content = string(content)
+	return createPost(spec)
+}
+
+// markdownTitle returns the text of the first level-one heading, if any.
+func markdownTitle(content string) string {
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "# ") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "# "))
+		}
+	}
+	return ""
 }
 
 func (m newPostModel) Init() tea.Cmd {
@@ -134,19 +366,45 @@ func (m newPostModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.err = nil
 				return m, nil
 			case 1: // description step
-				m.step = 2
 				m.description.Blur()
+				if m.skipFilename {
+					if m.skipPublic {
+						return m, tea.Quit
+					}
+					m.step = 3
+					return m, nil
+				}
+				m.step = 2
+				m.filename.Placeholder = slugify(m.title.Value()) + ".md"
+				m.filename.Focus()
 				return m, nil
-			case 2: // public/private step
+			case 2: // filename step
+				filename := strings.TrimSpace(m.filename.Value())
+				if filename == "" {
+					filename = m.filename.Placeholder
+				}
+				if err := validatePostFilename(filename); err != nil {
+					m.err = err
+					return m, nil
+				}
+				m.filename.SetValue(filename)
+				m.filename.Blur()
+				m.err = nil
+				if m.skipPublic {
+					return m, tea.Quit
+				}
+				m.step = 3
+				return m, nil
+			case 3: // public/private step
 				return m, tea.Quit
 			}
 		case "y", "Y":
-			if m.step == 2 {
+			if m.step == 3 {
 				m.isPublic = true
 				return m, tea.Quit
 			}
 		case "n", "N":
-			if m.step == 2 {
+			if m.step == 3 {
 				m.isPublic = false
 				return m, tea.Quit
 			}
@@ -159,6 +417,8 @@ func (m newPostModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.title, cmd = m.title.Update(msg)
 	case 1:
 		m.description, cmd = m.description.Update(msg)
+	case 2:
+		m.filename, cmd = m.filename.Update(msg)
 	}
 
 	return m, cmd
@@ -183,11 +443,22 @@ func (m newPostModel) View() string {
 		s.WriteString("\n\n")
 		s.WriteString(helpStyle.Render("Press Enter to continue (or leave empty)"))
 	case 2:
+		s.WriteString(fmt.Sprintf("Title: %s\n\n", m.title.Value()))
+		s.WriteString("Primary file name (use another extension for snippets, e.g. .py):\n\n")
+		s.WriteString(inputStyle.Render(m.filename.View()))
+		s.WriteString("\n\n")
+		s.WriteString(helpStyle.Render("Press Enter for the default name"))
+	case 3:
 		s.WriteString(fmt.Sprintf("Title: %s\n", m.title.Value()))
 		if m.description.Value() != "" {
 			s.WriteString(fmt.Sprintf("Description: %s\n", m.description.Value()))
 		}
-		s.WriteString("\nShould this post be public? (y/n): ")
+		s.WriteString(fmt.Sprintf("File: %s\n", m.filename.Value()))
+		if m.isPublic {
+			s.WriteString("\nShould this post be public? (Y/n): ")
+		} else {
+			s.WriteString("\nShould this post be public? (y/N): ")
+		}
 	}
 
 	if m.err != nil {
@@ -201,101 +472,315 @@ func (m newPostModel) View() string {
 	return s.String()
 }
 
-func createPost(m newPostModel) error {
+// createPost writes a new post to disk and returns its ID.
+func createPost(spec postSpec) (string, error) {
 	// Load config
-	configData, err := os.ReadFile(".gblog/config.json")
+	config, err := loadConfig()
 	if err != nil {
-		return fmt.Errorf("failed to read config: %w", err)
-	}
-
-	var config Config
-	if err := json.Unmarshal(configData, &config); err != nil {
-		return fmt.Errorf("failed to parse config: %w", err)
+		return "", err
 	}
 
 	// Generate post ID and directory name
-	postID := fmt.Sprintf("%04d", config.NextID)
-	slug := slugify(m.title.Value())
-	dirName := fmt.Sprintf("%s-%s", postID, slug)
+	id := config.NextID
+	if spec.ID > 0 {
+		id = spec.ID
+	}
+	postID := fmt.Sprintf("%04d", id)
+	slug := slugify(spec.Title)
+	createdAt := time.Now().UTC()
+	dirName := newPostDirPath(config, fmt.Sprintf("%s-%s", postID, slug), createdAt)
 	postDir := filepath.Join("posts", dirName)
 
+	filename := spec.Filename
+	if filename == "" {
+		filename = fmt.Sprintf("%s.md", slug)
+	}
+	if err := validatePostFilename(filename); err != nil {
+		return "", err
+	}
+
+	// Refuse to reuse an ID that already has a directory
+	if existing, err := findPostDir(postID); err == nil {
+		if spec.ID > 0 {
+			return "", fmt.Errorf("post ID %s already exists (%s)", postID, existing)
+		}
+		return "", fmt.Errorf("post ID %s is already used by %s; set a free ID with 'gblog config set next_id %d'",
+			postID, existing, nextFreeID())
+	}
+
+	// Catch accidentally re-creating a post that already exists
+	if !spec.AllowDuplicate {
+		if existing := findPostByTitle(spec.Title); existing != nil {
+			fmt.Printf("⚠️  Post %s already has the title %q (posts/%s)\n", existing.Meta.ID, existing.Meta.Title, existing.Dir)
+			ok, err := confirm("Create another post with the same title?")
+			if err != nil {
+				return "", fmt.Errorf("post %s already has this title; use --allow-duplicate to create it anyway", existing.Meta.ID)
+			}
+			if !ok {
+				fmt.Println("Cancelled.")
+				return "", nil
+			}
+		}
+	}
+
 	// Create post directory
 	if err := os.MkdirAll(postDir, 0755); err != nil {
-		return fmt.Errorf("failed to create post directory: %w", err)
+		return "", fmt.Errorf("failed to create post directory: %w", err)
 	}
 
 	// Create metadata file
 	meta := PostMeta{
 		ID:          postID,
-		Title:       m.title.Value(),
-		Description: m.description.Value(),
-		Public:      m.isPublic,
-		CreatedAt:   time.Now(),
+		Title:       spec.Title,
+		Description: spec.Description,
+		Public:      spec.Public,
+		Tags:        normalizeTags(spec.Tags),
+		Series:      strings.TrimSpace(spec.Series),
+		SeriesOrder: spec.SeriesOrder,
+		CreatedAt:   createdAt,
 	}
 
-	metaPath := filepath.Join(postDir, ".meta.json")
-	metaFile, err := os.Create(metaPath)
-	if err != nil {
-		return fmt.Errorf("failed to create metadata file: %w", err)
+	// Append to the end of the series unless a position was given
+	if meta.Series != "" && meta.SeriesOrder <= 0 {
+		meta.SeriesOrder = nextSeriesOrder(meta.Series)
 	}
-	defer metaFile.Close()
 
-	encoder := json.NewEncoder(metaFile)
-	encoder.SetIndent("", "  ")
-	if err := encoder.Encode(meta); err != nil {
-		return fmt.Errorf("failed to write metadata: %w", err)
+	if err := savePostMeta(postDir, meta); err != nil {
+		return "", err
 	}
 
-	// Create markdown file with descriptive name
-	mdFilename := fmt.Sprintf("%s.md", slug)
-	mdPath := filepath.Join(postDir, mdFilename)
-	mdContent := fmt.Sprintf("# %s\n\n", m.title.Value())
-	if m.description.Value() != "" {
-		mdContent += fmt.Sprintf("*%s*\n\n", m.description.Value())
+	// Create primary file with descriptive name
+	mdPath := filepath.Join(postDir, filename)
+	mdContent := spec.Content
+	if mdContent == "" {
+		mdContent = defaultPostContent(filename, spec.Title, spec.Description)
 	}
-	mdContent += "Write your post content here...\n"
 
 	if err := os.WriteFile(mdPath, []byte(mdContent), 0644); err != nil {
-		return fmt.Errorf("failed to create markdown file: %w", err)
+		return "", fmt.Errorf("failed to create post file: %w", err)
 	}
 
 	// Update config with next ID
-	config.NextID++
-	configFile, err := os.Create(".gblog/config.json")
-	if err != nil {
-		return fmt.Errorf("failed to update config: %w", err)
+	if id >= config.NextID {
+		config.NextID = id + 1
 	}
-	defer configFile.Close()
-
-	encoder = json.NewEncoder(configFile)
-	encoder.SetIndent("", "  ")
-	if err := encoder.Encode(config); err != nil {
-		return fmt.Errorf("failed to write updated config: %w", err)
+	if err := saveConfig(config); err != nil {
+		return "", err
 	}
 
 	// Add to .gitignore if private
-	if !m.isPublic {
-		gitignoreEntry := fmt.Sprintf("posts/%s/\n", dirName)
-		file, err := os.OpenFile(".gitignore", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-		if err != nil {
-			fmt.Printf("Warning: could not update .gitignore: %v\n", err)
-		} else {
-			defer file.Close()
-			file.WriteString(gitignoreEntry)
+	if !spec.Public {
+		if err := addGitignoreEntry(gitignorePostEntry(dirName)); err != nil {
+			logWarn(fmt.Sprintf("Warning: could not update .gitignore: %v", err))
 		}
 	}
 
+	recordHistory("create", postID, "", spec.Title)
+
+	if logger != nil {
+		logger.Info("created post", "post_id", postID, "dir", filepath.Join("posts", dirName), "file", filename)
+		return postID, nil
+	}
+	if spec.Quiet {
+		return postID, nil
+	}
+
 	fmt.Printf("✅ Created new post: %s\n", dirName)
 	fmt.Printf("📁 Directory: posts/%s/\n", dirName)
-	fmt.Printf("📝 Edit your post: posts/%s/%s.md\n", dirName, slug)
-	if !m.isPublic {
+	fmt.Printf("📝 Edit your post: posts/%s/%s\n", dirName, filename)
+	if meta.Series != "" {
+		fmt.Printf("📚 Series: %s (part %d)\n", meta.Series, meta.SeriesOrder)
+	}
+	if !spec.Public {
 		fmt.Printf("🔒 This post is private and added to .gitignore\n")
 	}
 	fmt.Printf("\nWhen ready, publish with: gblog publish %s\n", postID)
 
+	return postID, nil
+}
+
+// findPostByTitle returns the first post whose title matches title,
+// ignoring case and surrounding space, or nil.
+func findPostByTitle(title string) *PostInfo {
+	posts, err := loadPosts()
+	if err != nil {
+		return nil
+	}
+	title = strings.TrimSpace(title)
+	for i := range posts {
+		if strings.EqualFold(strings.TrimSpace(posts[i].Meta.Title), title) {
+			return &posts[i]
+		}
+	}
 	return nil
 }
 
+// validatePostFilename checks that name is a plain, publishable file name.
+func validatePostFilename(name string) error {
+	switch {
+	case name == "":
+		return fmt.Errorf("file name cannot be empty")
+	case strings.ContainsAny(name, `/\`):
+		return fmt.Errorf("file name %q cannot contain path separators", name)
+	case strings.HasPrefix(name, "."):
+		return fmt.Errorf("file name %q cannot start with '.' (hidden files are not published)", name)
+	}
+	return nil
+}
+
+// defaultPostContent returns the starter content for a new post file,
+// using a comment header for code files and a markdown template otherwise.
+func defaultPostContent(filename, title, description string) string {
+	ext := strings.ToLower(filepath.Ext(filename))
+
+	switch ext {
+	case ".md", ".markdown", "":
+		content := fmt.Sprintf("# %s\n\n", title)
+		if description != "" {
+			content += fmt.Sprintf("*%s*\n\n", description)
+		}
+		return content + "Write your post content here...\n"
+	}
+
+	comment := commentPrefix(ext)
+	if comment == "" {
+		return title + "\n"
+	}
+
+	content := fmt.Sprintf("%s %s\n", comment, title)
+	if description != "" {
+		content += fmt.Sprintf("%s %s\n", comment, description)
+	}
+	return content
+}
+
+// commentPrefix returns the line comment marker for a source file
+// extension such as ".go", or "" when gblog doesn't know one.
+func commentPrefix(ext string) string {
+	switch strings.ToLower(ext) {
+	case ".py", ".sh", ".bash", ".rb", ".pl", ".r", ".yaml", ".yml", ".toml":
+		return "#"
+	case ".go", ".js", ".ts", ".c", ".h", ".cpp", ".java", ".rs", ".swift", ".kt", ".cs", ".php":
+		return "//"
+	case ".sql", ".lua", ".hs":
+		return "--"
+	}
+	return ""
+}
+
+// loadPostMeta reads the .meta.json file from a post directory.
+func loadPostMeta(postDir string) (PostMeta, error) {
+	var meta PostMeta
+
+	metaData, err := os.ReadFile(filepath.Join(postDir, ".meta.json"))
+	if err != nil {
+		return meta, fmt.Errorf("failed to read post metadata: %w", err)
+	}
+
+	if err := json.Unmarshal(metaData, &meta); err != nil {
+		return meta, fmt.Errorf("failed to parse metadata: %w", err)
+	}
+
+	if err := validatePostMeta(meta, postDir); err != nil {
+		return meta, err
+	}
+
+	return meta, nil
+}
+
+// validatePostMeta checks the required metadata fields of the post in dir.
+func validatePostMeta(meta PostMeta, dir string) error {
+	var problems []string
+
+	dirName := filepath.Base(dir)
+	switch {
+	case meta.ID == "":
+		problems = append(problems, "id is missing")
+	case !strings.HasPrefix(dirName, meta.ID+"-"):
+		problems = append(problems, fmt.Sprintf("id %q does not match directory %q", meta.ID, dirName))
+	}
+
+	if strings.TrimSpace(meta.Title) == "" {
+		problems = append(problems, "title is missing")
+	}
+
+	switch {
+	case meta.CreatedAt.IsZero():
+		problems = append(problems, "created_at is missing")
+	case meta.CreatedAt.After(time.Now().Add(24 * time.Hour)):
+		problems = append(problems, fmt.Sprintf("created_at %s is in the future", meta.CreatedAt.Format(time.RFC3339)))
+	}
+
+	if meta.RemoteURL != "" && meta.RemoteID == "" {
+		problems = append(problems, "remote_url is set but remote_id is missing")
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid metadata in %s: %s",
+			filepath.Join(dir, ".meta.json"), strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// savePostMeta writes the .meta.json file in a post directory.
+func savePostMeta(postDir string, meta PostMeta) error {
+	if err := writeJSONAtomic(filepath.Join(postDir, ".meta.json"), meta); err != nil {
+		return fmt.Errorf("failed to write metadata: %w", err)
+	}
+
+	return nil
+}
+
+// primaryPostFile returns the post's main file: the markdown file named
+// after the directory slug, or the first markdown file if that is missing.
+func primaryPostFile(postDir string) (string, error) {
+	dirName := filepath.Base(postDir)
+	if i := strings.Index(dirName, "-"); i >= 0 {
+		candidate := filepath.Join(postDir, dirName[i+1:]+".md")
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, nil
+		}
+	}
+
+	files, err := getGistFiles(postDir)
+	if err != nil {
+		return "", err
+	}
+	for _, file := range files {
+		if strings.ToLower(filepath.Ext(file)) == ".md" {
+			return file, nil
+		}
+	}
+	if len(files) > 0 {
+		return files[0], nil
+	}
+
+	return "", fmt.Errorf("no post file found in %s", postDir)
+}
+
+// nextFreeID returns one past the highest numeric post ID on disk.
+func nextFreeID() int {
+	return nextFreeIDIn("posts")
+}
+
+// nextFreeIDIn is nextFreeID for the posts directory at postsDir.
+func nextFreeIDIn(postsDir string) int {
+	maxID := 0
+	dirs, err := listPostDirs(postsDir)
+	if err != nil {
+		return 1
+	}
+
+	for _, dir := range dirs {
+		prefix, _, _ := strings.Cut(filepath.Base(dir), "-")
+		if id, err := strconv.Atoi(prefix); err == nil && id > maxID {
+			maxID = id
+		}
+	}
+
+	return maxID + 1
+}
+
 func slugify(s string) string {
 	// Convert to lowercase
 	s = strings.ToLower(s)
@@ -0,0 +1,89 @@
+// cmd/new_test.go
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// chdirTemp moves into a fresh temporary directory for the rest of the
+// test.
+func chdirTemp(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+	return dir
+}
+
+func TestCreatePostRefusesTakenNextID(t *testing.T) {
+	chdirTemp(t)
+
+	if err := os.MkdirAll(".gblog", 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := saveConfig(Config{NextID: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	existingDir := filepath.Join("posts", "0001-existing")
+	if err := os.MkdirAll(existingDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := savePostMeta(existingDir, PostMeta{ID: "0001", Title: "Existing"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(existingDir, "existing.md"), []byte("# Existing\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	before := readDirFiles(t, existingDir)
+
+	if _, err := createPost(postSpec{Title: "New", AllowDuplicate: true, Quiet: true}); err == nil {
+		t.Fatal("createPost succeeded with next_id pointing at an existing post")
+	}
+
+	after := readDirFiles(t, existingDir)
+	if len(after) != len(before) {
+		t.Fatalf("existing post has %d files, want %d", len(after), len(before))
+	}
+	for name, data := range before {
+		if !bytes.Equal(after[name], data) {
+			t.Errorf("%s changed:\n%s\nwant:\n%s", name, after[name], data)
+		}
+	}
+
+	entries, err := os.ReadDir("posts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("posts/ has %d entries, want only the existing post", len(entries))
+	}
+}
+
+// readDirFiles returns the contents of each file directly in dir.
+func readDirFiles(t *testing.T, dir string) map[string][]byte {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string][]byte{}
+	for _, entry := range entries {
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[entry.Name()] = data
+	}
+	return files
+}
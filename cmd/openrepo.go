@@ -0,0 +1,88 @@
+// cmd/openrepo.go
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var openRepoCmd = &cobra.Command{
+	Use:   "open-repo",
+	Short: "Open the blog's repository in a browser",
+	Long: `Open the web page of the blog's git repository, taken from the origin
+remote. SSH remotes such as git@github.com:you/blog.git are turned into
+their https address. With --print, the URL is only printed.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		printOnly, _ := cmd.Flags().GetBool("print")
+		return openRepo(printOnly)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(openRepoCmd)
+	openRepoCmd.Flags().Bool("print", false, "Print the repository URL instead of opening it")
+}
+
+func openRepo(printOnly bool) error {
+	// Check if gblog is initialized
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+
+	output, err := exec.Command("git", "remote", "get-url", "origin").Output()
+	if err != nil {
+		return fmt.Errorf("no origin remote found; add one with 'git remote add origin <url>'")
+	}
+
+	webURL, err := repoWebURL(strings.TrimSpace(string(output)))
+	if err != nil {
+		return err
+	}
+
+	if printOnly {
+		fmt.Println(webURL)
+		return nil
+	}
+
+	fmt.Printf("🌐 Opening %s...\n", webURL)
+	if err := openInBrowser(webURL); err != nil {
+		fmt.Printf("⚠️  Could not open browser automatically: %v\n", err)
+		fmt.Printf("Please visit: %s\n", webURL)
+	}
+	return nil
+}
+
+// repoWebURL converts a git remote URL (https, ssh://, git://, or the
+// scp-like user@host:path form) into the repository's https web address.
+func repoWebURL(remote string) (string, error) {
+	var host, path string
+
+	if u, err := url.Parse(remote); err == nil && u.Scheme != "" && u.Host != "" {
+		switch u.Scheme {
+		case "http", "https", "ssh", "git", "git+ssh":
+			host, path = u.Hostname(), u.Path
+		default:
+			return "", fmt.Errorf("can't turn remote %s into a web URL", remote)
+		}
+	} else if at, rest, ok := strings.Cut(remote, "@"); ok && !strings.Contains(at, "/") {
+		// scp-like syntax: git@github.com:user/blog.git
+		host, path, ok = strings.Cut(rest, ":")
+		if !ok {
+			return "", fmt.Errorf("can't turn remote %s into a web URL", remote)
+		}
+	} else {
+		return "", fmt.Errorf("can't turn remote %s into a web URL", remote)
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	if host == "" || path == "" {
+		return "", fmt.Errorf("can't turn remote %s into a web URL", remote)
+	}
+	return fmt.Sprintf("https://%s/%s", host, path), nil
+}
@@ -0,0 +1,107 @@
+// cmd/promote.go
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var promoteCmd = &cobra.Command{
+	Use:   "promote <post-id>",
+	Short: "Check, publish, and commit a post in one step",
+	Long: `Ship a post: spellcheck and linkcheck it, publish (or update) its gist,
+then commit and push the post to the blog repository.
+
+Check results are warnings only and never stop the publish. Skip them
+with --skip-spellcheck and --skip-linkcheck, or skip the commit with
+--no-commit.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		skipSpell, _ := cmd.Flags().GetBool("skip-spellcheck")
+		skipLinks, _ := cmd.Flags().GetBool("skip-linkcheck")
+		noCommit, _ := cmd.Flags().GetBool("no-commit")
+		yes, _ := cmd.Flags().GetBool("yes")
+		noBrowser, _ := cmd.Flags().GetBool("no-browser")
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+		return promotePost(args[0], promoteOptions{
+			skipSpellcheck: skipSpell,
+			skipLinkcheck:  skipLinks,
+			linkTimeout:    timeout,
+			publish: publishOptions{
+				yes:       yes,
+				noBrowser: noBrowser,
+				commit:    !noCommit,
+			},
+		})
+	},
+}
+
+// promoteOptions holds the settings for promotePost.
+type promoteOptions struct {
+	skipSpellcheck bool
+	skipLinkcheck  bool
+	linkTimeout    time.Duration
+	publish        publishOptions
+}
+
+func init() {
+	rootCmd.AddCommand(promoteCmd)
+	promoteCmd.Flags().Bool("skip-spellcheck", false, "Don't spellcheck the post first")
+	promoteCmd.Flags().Bool("skip-linkcheck", false, "Don't check the post's links first")
+	promoteCmd.Flags().Bool("no-commit", false, "Don't commit and push the post after publishing")
+	promoteCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt for public gists")
+	promoteCmd.Flags().Bool("no-browser", false, "Don't open the gist in a browser")
+	promoteCmd.Flags().Duration("timeout", 10*time.Second, "Timeout for each link request")
+}
+
+func promotePost(postID string, opts promoteOptions) error {
+	postDir, err := findPostDir(postID)
+	if err != nil {
+		return err
+	}
+
+	meta, err := loadPostMeta(postDir)
+	if err != nil {
+		return err
+	}
+
+	// Checks only warn; a flaky link shouldn't block shipping
+	if !opts.skipSpellcheck {
+		logInfo("🔤 Spellcheck", "post_id", meta.ID)
+		if err := spellcheckPost(meta.ID); err != nil {
+			logWarn(fmt.Sprintf("⚠️  Spellcheck failed: %v", err), "post_id", meta.ID)
+		}
+		if logger == nil {
+			fmt.Println()
+		}
+	}
+	if !opts.skipLinkcheck {
+		logInfo("🔗 Linkcheck", "post_id", meta.ID)
+		if err := linkcheckPosts([]string{postDir}, opts.linkTimeout); err != nil {
+			logWarn(fmt.Sprintf("⚠️  Linkcheck failed: %v", err), "post_id", meta.ID)
+		}
+		if logger == nil {
+			fmt.Println()
+		}
+	}
+
+	opts.publish.update = meta.RemoteID != ""
+	if err := publishPost(meta.ID, opts.publish); err != nil {
+		return err
+	}
+
+	meta, err = loadPostMeta(postDir)
+	if err != nil {
+		return err
+	}
+
+	if logger == nil {
+		fmt.Println()
+	}
+	logInfo(fmt.Sprintf("🚀 Promoted %s: %s", meta.ID, meta.Title), "post_id", meta.ID, "gist_id", meta.RemoteID)
+	logInfo(fmt.Sprintf("🔗 %s", meta.RemoteURL), "post_id", meta.ID, "gist_url", meta.RemoteURL)
+
+	return nil
+}
@@ -0,0 +1,33 @@
+// cmd/prompt.go
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/x/term"
+)
+
+// confirm asks a yes/no question on stdin and reports whether the user
+// answered yes. It fails when stdin is not a terminal, so callers should
+// offer a flag (usually --yes) to skip the question in scripts.
+func confirm(question string) (bool, error) {
+	if !term.IsTerminal(os.Stdin.Fd()) {
+		return false, fmt.Errorf("confirmation required but stdin is not a terminal (use --yes to skip)")
+	}
+
+	fmt.Printf("%s (y/n): ", question)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return false, fmt.Errorf("failed to read answer: %w", err)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
@@ -2,36 +2,260 @@
 package cmd
 
 import (
-	"encoding/json"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/charmbracelet/x/term"
 	"github.com/spf13/cobra"
 )
 
 var publishCmd = &cobra.Command{
-	Use:   "publish <post-id>",
+	Use:   "publish [post-id...]",
 	Short: "Publish a post to GitHub Gists",
 	Long: `Publish a blog post to GitHub Gists.
 
 This command will upload all files in the post directory to a new gist
-and open it in your default browser. Use --update to update an existing gist.`,
-	Args: cobra.ExactArgs(1),
+and open it in your default browser. Use --update to update an existing gist.
+
+Several post IDs are published one after another, each creating or
+updating its gist as needed, so pending posts can be piped in:
+
+  gblog publish $(gblog list --modified --id-only)
+
+Without a post ID (or with --select), drafts and modified posts are listed
+with checkboxes; the chosen ones are published or updated one after
+another.
+
+--update-all-published updates the gist of every published post whose
+files changed since it was last published (after a find-and-replace
+across posts, say), skipping unchanged ones, and prints how many were
+updated, skipped, and failed. Flags that describe a single gist, such as
+--desc, --gist-file-name, and --public/--private, can't be combined
+with it.
+
+Markdown is linted first (see 'gblog lint'); problems are warnings
+unless --strict is given, and --no-lint skips the checks.
+
+With --readme-first, the main markdown file is given a "0-" prefix in
+the gist when needed so it is listed before the other files.
+
+Gists can't hold two files whose names differ only by case (README.md
+and readme.md), so publish stops and names them. --lowercase-names
+lowercases every gist file name instead, adding -2, -3, ... to names
+that still clash; the local files keep their names.
+
+--public or --private overrides the post's visibility for this gist only;
+.meta.json keeps its setting.
+
+Public gists are confirmed before they're created. The first time in a
+blog, the prompt explains what public means and the answer is saved as
+acknowledged_public; --confirm-public gives that acknowledgment up front
+and --yes skips the prompt entirely.
+
+The gist description is the post description, or the title when the
+post has none, so gists are never unlabeled. --desc sets it for this
+publish, --desc-from-title always uses the title, and --desc-from-firstline
+uses the first line of prose in the markdown.
+
+--update refuses to overwrite a gist that was edited on GitHub since
+gblog last published it. Review the gist, then use --force to overwrite
+those edits anyway.
+
+With --from-stdin, markdown read from stdin is published as a one-off
+gist without a post directory. The gist file is named after --title (or
+the first '# ' heading), and the description defaults to the title.
+Visibility follows --public/--private, then default_public. Add --save to
+record it as a new post as well; since stdin can't answer the duplicate
+title prompt, add --allow-duplicate when a post already has the title.
+
+  pbpaste | gblog publish --from-stdin --title "Quick note" --private`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		fromStdin, _ := cmd.Flags().GetBool("from-stdin")
+		selectPosts, _ := cmd.Flags().GetBool("select")
+		updateAll, _ := cmd.Flags().GetBool("update-all-published")
+		if fromStdin || selectPosts || updateAll {
+			return cobra.NoArgs(cmd, args)
+		}
+		return nil
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		update, _ := cmd.Flags().GetBool("update")
-		return publishPost(args[0], update)
+		embedImages, _ := cmd.Flags().GetBool("embed-images")
+		yes, _ := cmd.Flags().GetBool("yes")
+		noBrowser, _ := cmd.Flags().GetBool("no-browser")
+		desc, _ := cmd.Flags().GetString("desc")
+		saveDesc, _ := cmd.Flags().GetBool("save-desc")
+		commit, _ := cmd.Flags().GetBool("commit")
+		anonymous, _ := cmd.Flags().GetBool("anonymous")
+		gistFileNames, _ := cmd.Flags().GetStringArray("gist-file-name")
+		waitOpen, _ := cmd.Flags().GetBool("wait-open")
+		readmeFirst, _ := cmd.Flags().GetBool("readme-first")
+		noLint, _ := cmd.Flags().GetBool("no-lint")
+		strict, _ := cmd.Flags().GetBool("strict")
+		force, _ := cmd.Flags().GetBool("force")
+		confirmPublic, _ := cmd.Flags().GetBool("confirm-public")
+		lowercaseNames, _ := cmd.Flags().GetBool("lowercase-names")
+		if saveDesc && !cmd.Flags().Changed("desc") {
+			return fmt.Errorf("--save-desc requires --desc")
+		}
+		descFrom := ""
+		if fromTitle, _ := cmd.Flags().GetBool("desc-from-title"); fromTitle {
+			descFrom = "title"
+		}
+		if fromFirstLine, _ := cmd.Flags().GetBool("desc-from-firstline"); fromFirstLine {
+			descFrom = "firstline"
+		}
+		var public *bool
+		if cmd.Flags().Changed("public") || cmd.Flags().Changed("private") {
+			private, _ := cmd.Flags().GetBool("private")
+			public = new(bool)
+			*public = !private
+		}
+		opts := publishOptions{
+			update:      update,
+			embedImages: embedImages,
+			yes:         yes,
+			noBrowser:   noBrowser,
+			desc:        desc,
+			descSet:     cmd.Flags().Changed("desc"),
+			saveDesc:    saveDesc,
+			commit:      commit,
+			anonymous:   anonymous,
+			fileNames:   gistFileNames,
+			waitOpen:    waitOpen,
+			public:      public,
+			readmeFirst: readmeFirst,
+			noLint:      noLint,
+			strictLint:  strict,
+			force:       force,
+			descFrom:    descFrom,
+
+			confirmPublic:  confirmPublic,
+			lowercaseNames: lowercaseNames,
+		}
+		if fromStdin, _ := cmd.Flags().GetBool("from-stdin"); fromStdin {
+			title, _ := cmd.Flags().GetString("title")
+			save, _ := cmd.Flags().GetBool("save")
+			allowDuplicate, _ := cmd.Flags().GetBool("allow-duplicate")
+			if allowDuplicate && !save {
+				return fmt.Errorf("--allow-duplicate only applies with --save")
+			}
+			return publishFromStdin(title, save, allowDuplicate, opts)
+		}
+		if cmd.Flags().Changed("title") || cmd.Flags().Changed("save") || cmd.Flags().Changed("allow-duplicate") {
+			return fmt.Errorf("--title, --save, and --allow-duplicate only apply with --from-stdin")
+		}
+		if updateAll, _ := cmd.Flags().GetBool("update-all-published"); updateAll {
+			// These describe a single gist; applied to all of them they would
+			// overwrite each post's own settings
+			for _, name := range []string{"desc", "save-desc", "gist-file-name", "public", "private", "readme-first", "anonymous"} {
+				if cmd.Flags().Changed(name) {
+					return fmt.Errorf("--%s can't be combined with --update-all-published", name)
+				}
+			}
+			return publishAllPublished(opts)
+		}
+		if len(args) == 0 {
+			return publishSelected(opts)
+		}
+		if len(args) > 1 {
+			return publishPostIDs(args, opts)
+		}
+		return publishPost(args[0], opts)
 	},
 }
 
+// publishOptions holds the per-invocation settings for publishPost.
+type publishOptions struct {
+	update      bool
+	embedImages bool
+	yes         bool
+	noBrowser   bool
+	desc        string // gist description override
+	descSet     bool   // whether --desc was given
+	saveDesc    bool
+	commit      bool // commit and push the post after publishing
+	anonymous   bool
+	fileNames   []string // "local=gist" file name mappings
+	waitOpen    bool     // wait for the gist to be reachable before opening it
+	public      *bool    // one-off visibility override, not saved to .meta.json
+	readmeFirst bool     // name the primary file so the gist lists it first
+	noLint      bool     // skip the pre-publish lint pass
+	strictLint  bool     // refuse to publish when lint finds problems
+	force       bool     // overwrite a gist that changed on GitHub
+	descFrom    string   // derive the description from the "title" or "firstline"
+
+	confirmPublic  bool // acknowledge publishing publicly without a prompt
+	lowercaseNames bool // lowercase gist file names so case can't clash
+}
+
 func init() {
 	rootCmd.AddCommand(publishCmd)
 	publishCmd.Flags().BoolP("update", "u", false, "Update existing gist instead of creating new one")
+	publishCmd.Flags().Bool("embed-images", false, "Inline local images as base64 data URIs in the published markdown")
+	publishCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt for public gists")
+	publishCmd.Flags().Bool("confirm-public", false, "Confirm up front that public posts become publicly listed gists")
+	publishCmd.Flags().Bool("no-browser", false, "Don't open the gist in a browser (or set GBLOG_NO_BROWSER=1)")
+	publishCmd.Flags().String("desc", "", "Gist description to use instead of the post description")
+	publishCmd.Flags().Bool("save-desc", false, "Save the --desc value as the post description")
+	publishCmd.Flags().Bool("desc-from-title", false, "Use the post title as the gist description")
+	publishCmd.Flags().Bool("desc-from-firstline", false, "Use the first line of prose in the post as the gist description")
+	publishCmd.MarkFlagsMutuallyExclusive("desc", "desc-from-title", "desc-from-firstline")
+	publishCmd.Flags().Bool("commit", false, "Commit and push the post after publishing (or set auto_commit in config)")
+	publishCmd.Flags().Bool("anonymous", false, "Publish without tying the gist to your account (it can't be edited later)")
+	publishCmd.Flags().Bool("wait-open", false, "Wait until the gist is reachable before opening the browser")
+	publishCmd.Flags().Bool("public", false, "Publish as a public gist this time, without changing the post")
+	publishCmd.Flags().Bool("private", false, "Publish as a secret gist this time, without changing the post")
+	publishCmd.MarkFlagsMutuallyExclusive("public", "private")
+	publishCmd.Flags().Bool("readme-first", false, "Prefix the main markdown file's gist name so it is listed first")
+	publishCmd.Flags().Bool("force", false, "With --update, overwrite the gist even if it was edited on GitHub")
+	publishCmd.Flags().Bool("no-lint", false, "Skip the markdown lint checks before publishing")
+	publishCmd.Flags().Bool("strict", false, "Don't publish if the lint checks find problems")
+	publishCmd.Flags().StringArray("gist-file-name", nil, "Name a file differently in the gist, as local=gist (repeatable)")
+	publishCmd.Flags().Bool("lowercase-names", false, "Lowercase file names in the gist so names differing only by case don't clash")
+	publishCmd.Flags().Bool("select", false, "Pick the drafts and modified posts to publish from a list")
+	publishCmd.Flags().Bool("from-stdin", false, "Publish markdown from stdin as a one-off gist, without a post")
+	publishCmd.Flags().String("title", "", "Title of the --from-stdin gist (default: first '# ' heading)")
+	publishCmd.Flags().Bool("save", false, "With --from-stdin, also record the gist as a new post")
+	publishCmd.Flags().Bool("allow-duplicate", false, "With --from-stdin --save, save the post even if another has the same title")
+	publishCmd.Flags().Bool("update-all-published", false, "Update every published post whose files changed since its last publish")
+	publishCmd.MarkFlagsMutuallyExclusive("select", "from-stdin", "update-all-published")
+}
+
+// publishPostIDs publishes several posts, given by ID, one after another.
+func publishPostIDs(ids []string, opts publishOptions) error {
+	if opts.descSet || opts.saveDesc || len(opts.fileNames) > 0 {
+		return fmt.Errorf("--desc, --save-desc, and --gist-file-name apply to a single post")
+	}
+
+	// Resolve every ID first so a typo doesn't stop the run halfway
+	posts := make([]PostInfo, 0, len(ids))
+	for _, id := range ids {
+		postDir, err := findPostDir(id)
+		if err != nil {
+			return err
+		}
+		meta, err := loadPostMeta(postDir)
+		if err != nil {
+			return err
+		}
+		dir, _ := filepath.Rel("posts", postDir)
+		posts = append(posts, PostInfo{Meta: meta, Dir: dir})
+	}
+
+	return publishEach(posts, opts)
 }
 
-func publishPost(postID string, update bool) error {
+func publishPost(postID string, opts publishOptions) error {
 	// Find post directory
 	postDir, err := findPostDir(postID)
 	if err != nil {
@@ -39,102 +263,517 @@ func publishPost(postID string, update bool) error {
 	}
 
 	// Load metadata
-	metaPath := filepath.Join(postDir, ".meta.json")
-	metaData, err := os.ReadFile(metaPath)
+	meta, err := loadPostMeta(postDir)
 	if err != nil {
-		return fmt.Errorf("failed to read post metadata: %w", err)
-	}
-
-	var meta PostMeta
-	if err := json.Unmarshal(metaData, &meta); err != nil {
-		return fmt.Errorf("failed to parse metadata: %w", err)
+		return err
 	}
 
 	// Check if already published and handle accordingly
-	if meta.GistID != "" && !update {
-		fmt.Printf("⚠️  Post already published: %s\n", meta.GistURL)
-		fmt.Println("Use 'gblog publish --update' to update the existing gist.")
+	if meta.RemoteID != "" && !opts.update {
+		logWarn(fmt.Sprintf("⚠️  Post already published: %s", meta.RemoteURL), "post_id", meta.ID, "gist_id", meta.RemoteID)
+		logInfo("Use 'gblog publish --update' to update the existing gist.")
 		return nil
 	}
 
+	// Anonymous gists can't be edited, so updates publish a fresh one
+	anonymous := opts.anonymous || (meta.Anonymous && opts.update)
+	if anonymous {
+		logWarn("🕶️  Anonymous gists are not tied to your account and can never be edited or deleted.", "post_id", meta.ID)
+		if meta.Anonymous && opts.update {
+			logWarn("   The existing anonymous gist can't be updated; a new one will be created.", "post_id", meta.ID, "gist_id", meta.RemoteID)
+		}
+	}
+
+	// A one-off --public/--private only applies to a newly created gist
+	public := meta.Public
+	if opts.public != nil && *opts.public != meta.Public {
+		switch {
+		case meta.RemoteID != "" && opts.update && !anonymous:
+			logWarn("⚠️  A gist's visibility can't be changed after it is created; ignoring the override.", "post_id", meta.ID, "gist_id", meta.RemoteID)
+		case *opts.public:
+			public = true
+			logWarn(fmt.Sprintf("⚠️  Post %s is private, but will be published as a PUBLIC gist this time (.meta.json is unchanged).", meta.ID), "post_id", meta.ID)
+		default:
+			public = false
+			logInfo(fmt.Sprintf("🔒 Post %s is public, but will be published as a secret gist this time (.meta.json is unchanged).", meta.ID), "post_id", meta.ID)
+		}
+	}
+	gistMeta := meta
+	gistMeta.Public = public
+
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	// Gists are the only backend publishing knows so far
+	if backend := postBackend(meta, config); backend != gistBackend {
+		return fmt.Errorf("post %s uses the %s backend, which can't be published to yet", meta.ID, backend)
+	}
+
 	// Check gh CLI authentication
-	if err := checkGHAuth(); err != nil {
+	if !anonymous {
+		if err := checkGHAuth(); err != nil {
+			return err
+		}
+	}
+
+	// Record the GitHub user if init couldn't
+	if !anonymous && config.GitHubUser == "" {
+		if config.GitHubUser = getGitHubUser(); config.GitHubUser != "" {
+			if err := saveConfig(config); err != nil {
+				logWarn(fmt.Sprintf("⚠️  Could not save GitHub user to config: %v", err))
+			}
+		}
+	}
+
+	// Collect the files to upload
+	gistFiles, ignored, err := splitGistFiles(postDir)
+	if err != nil {
+		return err
+	}
+	for _, file := range ignored {
+		logInfo(fmt.Sprintf("🙈 Skipping %s (.gistignore)", filepath.Base(file)), "post_id", meta.ID, "file", file)
+	}
+
+	if len(gistFiles) == 0 {
+		return fmt.Errorf("no files found to publish in %s", postDir)
+	}
+
+	// Catch markdown that renders badly before it goes out
+	if !opts.noLint {
+		issues, err := lintPost(postDir)
+		if err != nil {
+			return err
+		}
+		if len(issues) > 0 {
+			logWarn(fmt.Sprintf("🧹 %d lint problem(s):", len(issues)), "post_id", meta.ID)
+			if logger == nil {
+				printLintIssues(issues)
+			}
+			if opts.strictLint {
+				return fmt.Errorf("not publishing: %d lint problem(s) (fix them or drop --strict)", len(issues))
+			}
+		}
+	}
+
+	// Handle relative image links, which gists can't render
+	gistFiles, cleanup, err := prepareImages(postDir, gistFiles, opts.embedImages)
+	if err != nil {
 		return err
 	}
+	defer cleanup()
+
+	// Present files under different names in the gist
+	renames, err := parseGistFileNames(opts.fileNames)
+	if err != nil {
+		return err
+	}
+	if opts.readmeFirst {
+		primary, err := primaryPostFile(postDir)
+		if err != nil {
+			return err
+		}
+		name, err := readmeFirstName(filepath.Base(primary), gistFiles, renames)
+		if err != nil {
+			return err
+		}
+		if name != "" {
+			logInfo(fmt.Sprintf("📌 Listing %s first as %s", filepath.Base(primary), name), "post_id", meta.ID)
+			renames[filepath.Base(primary)] = name
+		}
+	}
+	if opts.lowercaseNames {
+		lowercaseGistNames(gistFiles, renames)
+	}
+	if err := checkGistNameCase(gistFiles, renames); err != nil {
+		return err
+	}
+	gistFiles, cleanupRenames, err := renameGistFiles(gistFiles, renames)
+	if err != nil {
+		return err
+	}
+	defer cleanupRenames()
+
+	hash, err := publishedContentHash(gistFiles)
+	if err != nil {
+		return err
+	}
+	if meta.RemoteID == "" || !opts.update {
+		warnDuplicateContent(meta.ID, hash)
+	}
+
+	// Work out the gist description for this run
+	switch opts.descFrom {
+	case "title":
+		opts.desc, opts.descSet = meta.Title, true
+	case "firstline":
+		primary, err := primaryPostFile(postDir)
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(primary)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", primary, err)
+		}
+		if opts.desc = markdownFirstLine(string(content)); opts.desc == "" {
+			return fmt.Errorf("no line of prose found in %s for --desc-from-firstline", filepath.Base(primary))
+		}
+		opts.descSet = true
+	}
+	description := meta.Description
+	if opts.descSet {
+		description = opts.desc
+		if opts.saveDesc {
+			meta.Description = opts.desc
+		}
+	}
+	if strings.TrimSpace(description) == "" {
+		description = meta.Title
+	}
+
+	// Public gists are listed on the user's profile, and anonymous ones
+	// can never be edited or deleted, so make sure publishing one is
+	// intended
+	creating := anonymous || meta.RemoteID == "" || !opts.update
+	if creating && public && !opts.yes {
+		ok, err := confirmPublicGist(config, opts.confirmPublic)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
 
 	var gistURL, gistID string
 
-	if meta.GistID != "" && update {
-		// Update existing gist
-		gistURL, gistID, err = updateExistingGist(postDir, &meta)
+	if anonymous {
+		// Create new anonymous gist
+		gistURL, gistID, err = createAnonymousGist(gistFiles, &gistMeta, description)
+		if err != nil {
+			return err
+		}
+		logInfo("✅ Published anonymously!", "post_id", meta.ID, "gist_id", gistID)
+	} else if meta.RemoteID != "" && opts.update {
+		// Update existing gist, comparing against what is already there
+		remote, err := fetchGist(meta.RemoteID)
+		if err != nil {
+			logWarn(fmt.Sprintf("⚠️  Could not fetch the gist, uploading every file: %v", err), "post_id", meta.ID, "gist_id", meta.RemoteID)
+			remote = nil
+		}
+
+		// Don't clobber edits made on GitHub since the last publish
+		if err := checkRemoteRevision(meta, remote, opts.force); err != nil {
+			return err
+		}
+
+		// Only send the description when it was overridden or is stale
+		updateDesc := ""
+		if opts.descSet {
+			updateDesc = description
+		} else if remote != nil && description != "" && remote.Description != description {
+			logInfo(fmt.Sprintf("📝 Updating gist description to %q", description), "post_id", meta.ID, "gist_id", meta.RemoteID)
+			updateDesc = description
+		}
+
+		renamedFrom := map[string]string{}
+		for local, name := range renames {
+			renamedFrom[name] = local
+		}
+		updated, err := updateExistingGist(gistFiles, &meta, remote, updateDesc, renamedFrom)
 		if err != nil {
 			return err
 		}
-		fmt.Printf("✅ Updated existing gist!\n")
+		if !updated {
+			// Nothing to send, but the local post now matches the gist
+			now := time.Now().UTC()
+			meta.PublishedAt = &now
+			if remote != nil && len(remote.History) > 0 {
+				meta.RemoteRevision = remote.History[0].Version
+			}
+			meta.ContentHash = hash
+			if err := savePostMeta(postDir, meta); err != nil {
+				return err
+			}
+			logInfo("✅ Gist already up to date", "post_id", meta.ID, "gist_id", meta.RemoteID)
+			return nil
+		}
+		gistURL, gistID = meta.RemoteURL, meta.RemoteID
+		logInfo("✅ Updated existing gist!", "post_id", meta.ID, "gist_id", gistID)
 	} else {
 		// Create new gist
-		gistURL, gistID, err = createNewGist(postDir, &meta)
+		gistURL, gistID, err = createNewGist(gistFiles, &gistMeta, description)
 		if err != nil {
 			return err
 		}
-		fmt.Printf("✅ Published successfully!\n")
+		logInfo("✅ Published successfully!", "post_id", meta.ID, "gist_id", gistID)
 	}
 
 	// Update metadata with gist info
-	meta.GistID = gistID
-	meta.GistURL = gistURL
+	meta.RemoteID = gistID
+	meta.RemoteURL = gistURL
+	if !anonymous {
+		meta.RemoteURL = canonicalGistURL(config.GitHubUser, gistID, gistURL)
+	}
+	meta.Backend = gistBackend
+	meta.ContentHash = hash
+	meta.Anonymous = anonymous
+	now := time.Now().UTC()
+	meta.PublishedAt = &now
+	gistURL = meta.RemoteURL
+	if anonymous {
+		meta.RemoteRevision = ""
+	} else if meta.RemoteRevision == "" || !opts.update {
+		// Updates already recorded the revision from the PATCH response
+		meta.RemoteRevision = latestGistRevision(gistID)
+	}
 
-	metaFile, err := os.Create(metaPath)
-	if err != nil {
-		return fmt.Errorf("failed to update metadata: %w", err)
+	if err := savePostMeta(postDir, meta); err != nil {
+		return err
 	}
-	defer metaFile.Close()
 
-	encoder := json.NewEncoder(metaFile)
-	encoder.SetIndent("", "  ")
-	if err := encoder.Encode(meta); err != nil {
-		return fmt.Errorf("failed to write updated metadata: %w", err)
+	if opts.update && !anonymous {
+		recordHistory("update", meta.ID, gistID, "")
+	} else {
+		recordHistory("publish", meta.ID, gistID, gistURL)
 	}
 
-	fmt.Printf("🔗 Gist URL: %s\n", gistURL)
-	fmt.Printf("📝 Gist ID: %s\n", gistID)
+	logInfo(fmt.Sprintf("🔗 Gist URL: %s", gistURL), "post_id", meta.ID, "gist_url", gistURL)
+	logInfo(fmt.Sprintf("📝 Gist ID: %s", gistID), "post_id", meta.ID, "gist_id", gistID)
+	if config.GitHubUser != "" && logger == nil {
+		fmt.Printf("👤 Your gists: https://gist.github.com/%s\n", config.GitHubUser)
+	}
+
+	// Record the publish in the blog repository
+	if opts.commit || config.AutoCommit {
+		action := "Publish"
+		if opts.update {
+			action = "Update"
+		}
+		message := fmt.Sprintf("%s %s: %s", action, meta.ID, meta.Title)
+		if err := commitPostChanges(postDir, message); err != nil {
+			logWarn(fmt.Sprintf("⚠️  Could not commit: %v", err), "post_id", meta.ID)
+		}
+	}
 
 	// Open in browser
-	fmt.Println("🌐 Opening in browser...")
+	if !shouldOpenBrowser(opts, config) {
+		return nil
+	}
+
+	if opts.waitOpen {
+		logInfo("⏳ Waiting for the gist to become available...", "url", gistURL)
+		if err := waitForURL(gistURL, gistWaitTimeout); err != nil {
+			logWarn(fmt.Sprintf("⚠️  %v; opening anyway", err), "url", gistURL)
+		}
+	}
+
+	logInfo("🌐 Opening in browser...", "url", gistURL)
 	if err := openInBrowser(gistURL); err != nil {
-		fmt.Printf("⚠️  Could not open browser automatically: %v\n", err)
-		fmt.Printf("Please visit: %s\n", gistURL)
+		logWarn(fmt.Sprintf("⚠️  Could not open browser automatically: %v", err))
+		logInfo(fmt.Sprintf("Please visit: %s", gistURL), "url", gistURL)
 	}
 
 	return nil
 }
 
-func createNewGist(postDir string, meta *PostMeta) (string, string, error) {
-	// Prepare gist creation command
-	args := []string{"gist", "create"}
+// publishFromStdin publishes markdown read from stdin as a gist. With
+// save, it is recorded as a new post and published like any other;
+// otherwise it goes out straight from a temporary file.
+func publishFromStdin(title string, save, allowDuplicate bool, opts publishOptions) error {
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return errNotInitialized()
+	}
 
-	if meta.Public {
-		args = append(args, "--public")
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	content, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return fmt.Errorf("failed to read stdin: %w", err)
+	}
+	if strings.TrimSpace(string(content)) == "" {
+		return withExitCode(ExitNothingToDo, fmt.Errorf("nothing to publish: stdin was empty"))
+	}
+
+	if strings.TrimSpace(title) == "" {
+		title = markdownTitle(string(content))
+	}
+	title = strings.TrimSpace(title)
+
+	public := config.DefaultPublic
+	if opts.public != nil {
+		public = *opts.public
+	}
+
+	// stdin is the content, so there's no one to answer the prompt
+	if public && !opts.yes && !opts.confirmPublic {
+		return fmt.Errorf("publishing a public gist from stdin needs --yes or --confirm-public (or use --private)")
+	}
+
+	if save {
+		if title == "" {
+			return fmt.Errorf("could not find a '# ' heading; specify one with --title")
+		}
+		postID, err := createPost(postSpec{
+			Title:       title,
+			Description: opts.desc,
+			Public:      public,
+			PublicSet:   true,
+			Content:     string(content),
+
+			AllowDuplicate: allowDuplicate,
+		})
+		if err != nil {
+			return err
+		}
+		opts.public = nil
+		opts.descSet = false
+		return publishPost(postID, opts)
+	}
+
+	if err := checkGHAuth(); err != nil {
+		return err
+	}
+
+	filename := "gist.md"
+	if title != "" {
+		filename = slugify(title) + ".md"
+	}
+	description := title
+	if opts.descSet {
+		description = opts.desc
+	} else if line := markdownFirstLine(string(content)); opts.descFrom == "firstline" && line != "" {
+		description = line
+	}
+
+	tmpDir, err := os.MkdirTemp("", "gblog-stdin-")
+	if err != nil {
+		return fmt.Errorf("failed to create temp directory: %w", err)
 	}
+	defer os.RemoveAll(tmpDir)
 
-	if meta.Description != "" {
-		args = append(args, "--desc", meta.Description)
+	path := filepath.Join(tmpDir, filename)
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
 	}
 
-	// Add filename arguments for all files in the directory
-	gistFiles, err := getGistFiles(postDir)
+	logInfo(fmt.Sprintf("📤 Publishing %s from stdin...", filename), "file", filename)
+	gistURL, gistID, err := ghCreateGist([]string{path}, public, description)
 	if err != nil {
-		return "", "", err
+		return err
 	}
+	gistURL = canonicalGistURL(config.GitHubUser, gistID, gistURL)
+	recordHistory("publish", "", gistID, gistURL)
 
-	if len(gistFiles) == 0 {
-		return "", "", fmt.Errorf("no files found to publish in %s", postDir)
+	logInfo("✅ Published successfully!", "gist_id", gistID)
+	logInfo(fmt.Sprintf("🔗 Gist URL: %s", gistURL), "gist_url", gistURL)
+	logInfo("💡 Not saved as a post; add --save next time to keep a copy")
+
+	if shouldOpenBrowser(opts, config) {
+		logInfo("🌐 Opening in browser...", "url", gistURL)
+		if err := openInBrowser(gistURL); err != nil {
+			logWarn(fmt.Sprintf("⚠️  Could not open browser automatically: %v", err))
+			logInfo(fmt.Sprintf("Please visit: %s", gistURL), "url", gistURL)
+		}
 	}
 
-	args = append(args, gistFiles...)
+	return nil
+}
 
-	fmt.Printf("📤 Publishing post '%s'...\n", meta.Title)
-	fmt.Printf("Files: %v\n", gistFiles)
+// markdownFirstLine returns the first line of prose in markdown content,
+// skipping headings, front matter, code blocks, images, and blank lines.
+func markdownFirstLine(content string) string {
+	inFence := false
+	inFrontMatter := false
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case i == 0 && line == "---":
+			inFrontMatter = true
+			continue
+		case inFrontMatter:
+			if line == "---" {
+				inFrontMatter = false
+			}
+			continue
+		case strings.HasPrefix(line, "```"):
+			inFence = !inFence
+			continue
+		case inFence, line == "", strings.HasPrefix(line, "#"), strings.HasPrefix(line, "!["):
+			continue
+		}
+		return line
+	}
+	return ""
+}
+
+// shouldOpenBrowser decides whether to launch a browser after publishing.
+// It is skipped with --no-browser, open_after_publish set to false,
+// GBLOG_NO_BROWSER, or when stdout is not a terminal (CI, pipes, servers).
+func shouldOpenBrowser(opts publishOptions, config Config) bool {
+	if opts.noBrowser || !config.openAfterPublish() {
+		return false
+	}
+
+	switch strings.ToLower(os.Getenv("GBLOG_NO_BROWSER")) {
+	case "1", "true", "yes":
+		return false
+	}
+
+	return term.IsTerminal(os.Stdout.Fd())
+}
+
+// gistWaitTimeout bounds how long --wait-open polls a new gist.
+const gistWaitTimeout = 30 * time.Second
+
+// waitForURL polls url with HEAD requests until it responds 200 OK or
+// timeout elapses.
+func waitForURL(url string, timeout time.Duration) error {
+	client := &http.Client{Timeout: 5 * time.Second}
+	deadline := time.Now().Add(timeout)
+	for {
+		resp, err := client.Head(url)
+		if err == nil {
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return nil
+			}
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("gist not reachable after %s", timeout)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+func createNewGist(gistFiles []string, meta *PostMeta, description string) (string, string, error) {
+	logInfo(fmt.Sprintf("📤 Publishing post '%s'...", meta.Title), "post_id", meta.ID)
+	logInfo(fmt.Sprintf("Files: %v", gistFiles), "post_id", meta.ID, "files", gistFiles)
+
+	return ghCreateGist(gistFiles, meta.Public, description)
+}
+
+// ghCreateGist creates a gist from the files at gistFiles with
+// 'gh gist create' and returns its URL and ID.
+func ghCreateGist(gistFiles []string, public bool, description string) (string, string, error) {
+	// Prepare gist creation command
+	args := []string{"gist", "create"}
+
+	if public {
+		args = append(args, "--public")
+	}
+
+	if description != "" {
+		args = append(args, "--desc", description)
+	}
+
+	// Add filename arguments for all files
+	args = append(args, gistFiles...)
 
 	// Execute gh gist create
 	cmd := exec.Command("gh", args...)
@@ -158,78 +797,475 @@ func createNewGist(postDir string, meta *PostMeta) (string, string, error) {
 	return gistURL, gistID, nil
 }
 
-func updateExistingGist(postDir string, meta *PostMeta) (string, string, error) {
-	// Get all files to update
-	gistFiles, err := getGistFiles(postDir)
+// updateExistingGist uploads the files in gistFiles whose content differs
+// from the remote gist; with a nil remote every file is sent. Files named
+// in renamedFrom (gist name to previous name) are renamed in place. A
+// non-empty description also replaces the gist description. It reports
+// whether anything was sent.
+func updateExistingGist(gistFiles []string, meta *PostMeta, remote *gistResponse, description string, renamedFrom map[string]string) (bool, error) {
+	files := map[string]*gistFile{}
+	var changed []string
+	for _, path := range gistFiles {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return false, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+
+		name := filepath.Base(path)
+		key := name
+		if remote != nil {
+			if _, ok := remote.Files[name]; !ok {
+				// Rename the gist's copy of the file rather than adding one
+				if old, ok := renamedFrom[name]; ok {
+					if _, ok := remote.Files[old]; ok {
+						key = old
+					}
+				}
+			}
+			if current, ok := remote.Files[name]; ok && !current.Truncated &&
+				contentHash([]byte(current.Content)) == contentHash(content) {
+				continue
+			}
+		}
+
+		files[key] = &gistFile{Filename: name, Content: string(content)}
+		changed = append(changed, name)
+	}
+
+	if len(changed) == 0 && description == "" {
+		return false, nil
+	}
+
+	logInfo(fmt.Sprintf("📤 Updating existing gist '%s'...", meta.Title), "post_id", meta.ID, "gist_id", meta.RemoteID)
+	if len(changed) > 0 {
+		logInfo(fmt.Sprintf("Changed files: %v", changed), "post_id", meta.ID, "files", changed)
+	}
+
+	var desc *string
+	if description != "" {
+		desc = &description
+	}
+	if len(files) == 0 {
+		files = nil
+	}
+	revision, err := patchGist(meta.RemoteID, desc, files)
 	if err != nil {
-		return "", "", err
+		return false, fmt.Errorf("failed to update gist: %w", err)
 	}
+	meta.RemoteRevision = revision
 
-	if len(gistFiles) == 0 {
-		return "", "", fmt.Errorf("no files found to update in %s", postDir)
+	return true, nil
+}
+
+// checkRemoteRevision returns an error if the gist has a newer revision
+// than the one recorded at the last publish, unless force is set. Posts
+// published before revisions were recorded, or a gist that couldn't be
+// fetched, are let through with a warning at most.
+func checkRemoteRevision(meta PostMeta, remote *gistResponse, force bool) error {
+	if meta.RemoteRevision == "" {
+		return nil
+	}
+	if remote == nil || len(remote.History) == 0 {
+		logWarn("⚠️  Could not check the gist for edits made on GitHub.", "post_id", meta.ID, "gist_id", meta.RemoteID)
+		return nil
 	}
 
-	fmt.Printf("📤 Updating existing gist '%s'...\n", meta.Title)
-	fmt.Printf("Files: %v\n", gistFiles)
+	current := remote.History[0]
+	if current.Version == meta.RemoteRevision {
+		return nil
+	}
 
-	// Prepare update command
-	args := []string{"gist", "edit", meta.GistID}
-	args = append(args, gistFiles...)
+	if force {
+		logWarn(fmt.Sprintf("⚠️  Gist was edited on GitHub (revision %s); overwriting because of --force.", shortSHA(current.Version)),
+			"post_id", meta.ID, "gist_id", meta.RemoteID)
+		return nil
+	}
 
-	// Execute gh gist edit
-	cmd := exec.Command("gh", args...)
-	if err := cmd.Run(); err != nil {
-		if exitError, ok := err.(*exec.ExitError); ok {
-			return "", "", fmt.Errorf("failed to update gist: %s", string(exitError.Stderr))
+	return fmt.Errorf("gist %s was changed on GitHub since the last publish (revision %s, %s); "+
+		"review it at %s, copy over any edits you want to keep, then rerun with --force",
+		meta.RemoteID, shortSHA(current.Version), current.CommittedAt.Local().Format("2006-01-02 15:04"), meta.RemoteURL)
+}
+
+// contentHash returns the hex SHA-256 of data.
+func contentHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// publishedContentHash hashes the names and contents of the files about
+// to be published, independent of their order.
+func publishedContentHash(gistFiles []string) (string, error) {
+	paths := append([]string(nil), gistFiles...)
+	sort.Slice(paths, func(i, j int) bool {
+		return filepath.Base(paths[i]) < filepath.Base(paths[j])
+	})
+
+	hash := sha256.New()
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		fmt.Fprintf(hash, "%s\x00%d\x00", filepath.Base(path), len(content))
+		hash.Write(content)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// warnDuplicateContent warns when other published posts were last
+// published with exactly the content hashed as hash.
+func warnDuplicateContent(postID, hash string) {
+	posts, err := loadPosts()
+	if err != nil {
+		return
+	}
+
+	var duplicates []string
+	for _, post := range posts {
+		if post.Meta.ID != postID && post.Meta.RemoteID != "" && post.Meta.ContentHash == hash {
+			duplicates = append(duplicates, post.Meta.ID)
 		}
-		return "", "", fmt.Errorf("failed to update gist: %w", err)
+	}
+	if len(duplicates) == 0 {
+		return
 	}
 
-	// Return existing URL and ID
-	return meta.GistURL, meta.GistID, nil
+	logWarn(fmt.Sprintf("⚠️  Post %s has the same content as published post(s) %s; this gist would be a duplicate.",
+		postID, strings.Join(duplicates, ", ")), "post_id", postID, "duplicates", duplicates)
 }
 
+// confirmPublicGist asks before a public gist is created. The first time
+// in a project, it explains what public means and records the answer as
+// acknowledged_public; after that a short question is enough. With
+// preConfirmed (--confirm-public), it acknowledges without asking.
+func confirmPublicGist(config Config, preConfirmed bool) (bool, error) {
+	if preConfirmed {
+		acknowledgePublic(config)
+		return true, nil
+	}
+	if config.AcknowledgedPublic {
+		return confirm("Publish as a public gist?")
+	}
+
+	fmt.Println("🌍 This is the first public gist from this blog. Public gists are listed")
+	fmt.Println("   on your GitHub profile, appear in search results, and are indexed by")
+	fmt.Println("   search engines. Secret gists (private posts) are unlisted but still")
+	fmt.Println("   reachable by URL.")
+	if config.DefaultPublic {
+		fmt.Println("💡 This blog makes new posts public by default; change it with")
+		fmt.Println("   'gblog config set default_public false'")
+	}
+	ok, err := confirm("This gist will be publicly listed on your GitHub profile and indexed. Continue?")
+	if err != nil || !ok {
+		return ok, err
+	}
+	acknowledgePublic(config)
+	return true, nil
+}
+
+// acknowledgePublic saves acknowledged_public so the first-time public
+// gist explanation isn't shown again.
+func acknowledgePublic(config Config) {
+	if config.AcknowledgedPublic {
+		return
+	}
+	config.AcknowledgedPublic = true
+	if err := saveConfig(config); err != nil {
+		logWarn(fmt.Sprintf("⚠️  Could not save acknowledged_public to config: %v", err))
+	}
+}
+
+// canonicalGistURL builds the user-qualified gist URL when the GitHub user
+// is known, falling back to the URL reported by gh.
+func canonicalGistURL(githubUser, gistID, fallback string) string {
+	if githubUser == "" || gistID == "" {
+		return fallback
+	}
+	return fmt.Sprintf("https://gist.github.com/%s/%s", githubUser, gistID)
+}
+
+// getGistFiles returns the files in postDir that should be published.
 func getGistFiles(postDir string) ([]string, error) {
+	files, _, err := splitGistFiles(postDir)
+	return files, err
+}
+
+// splitGistFiles lists the publishable files in postDir, separating out
+// those matched by the post's .gistignore.
+func splitGistFiles(postDir string) ([]string, []string, error) {
 	files, err := os.ReadDir(postDir)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read post directory: %w", err)
+		return nil, nil, fmt.Errorf("failed to read post directory: %w", err)
+	}
+
+	patterns, err := readGistignore(postDir)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	var gistFiles []string
+	var gistFiles, ignored []string
 	for _, file := range files {
 		if file.IsDir() || strings.HasPrefix(file.Name(), ".") {
 			continue // Skip directories and hidden files like .meta.json
 		}
 
 		filePath := filepath.Join(postDir, file.Name())
+		if matchesAny(file.Name(), patterns) {
+			ignored = append(ignored, filePath)
+			continue
+		}
 		gistFiles = append(gistFiles, filePath)
 	}
 
-	return gistFiles, nil
+	return gistFiles, ignored, nil
+}
+
+// readGistignore returns the glob patterns in postDir/.gistignore, one per
+// line, skipping blank lines and # comments.
+func readGistignore(postDir string) ([]string, error) {
+	data, err := os.ReadFile(filepath.Join(postDir, ".gistignore"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read .gistignore: %w", err)
+	}
+
+	var patterns []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if _, err := filepath.Match(line, ""); err != nil {
+			return nil, fmt.Errorf("invalid .gistignore pattern %q: %w", line, err)
+		}
+		patterns = append(patterns, line)
+	}
+	return patterns, nil
+}
+
+// matchesAny reports whether name matches one of the glob patterns.
+func matchesAny(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// parseGistFileNames parses "local=gist" mappings into a map of local
+// file name to gist file name.
+func parseGistFileNames(specs []string) (map[string]string, error) {
+	renames := map[string]string{}
+	for _, spec := range specs {
+		local, name, ok := strings.Cut(spec, "=")
+		local, name = strings.TrimSpace(local), strings.TrimSpace(name)
+		if !ok || local == "" || name == "" {
+			return nil, fmt.Errorf("invalid --gist-file-name %q: expected local=gist", spec)
+		}
+		if err := validatePostFilename(name); err != nil {
+			return nil, err
+		}
+		if _, dup := renames[local]; dup {
+			return nil, fmt.Errorf("--gist-file-name given twice for %s", local)
+		}
+		renames[local] = name
+	}
+	return renames, nil
+}
+
+// readmeFirstName returns a gist name for primary that sorts before every
+// other gist file, or "" when it already does or was renamed explicitly.
+// Gists list files alphabetically, so the name gets a "0-" style prefix.
+// Zeros can't get ahead of names starting with "0-" or a byte below '0',
+// so it gives up after a few tries.
+func readmeFirstName(primary string, gistFiles []string, renames map[string]string) (string, error) {
+	if _, ok := renames[primary]; ok {
+		return "", nil
+	}
+
+	var others []string
+	for _, file := range gistFiles {
+		local := filepath.Base(file)
+		if local == primary {
+			continue
+		}
+		if name, ok := renames[local]; ok {
+			local = name
+		}
+		others = append(others, strings.ToLower(local))
+	}
+
+	sortsFirst := func(name string) bool {
+		for _, other := range others {
+			if strings.ToLower(name) >= other {
+				return false
+			}
+		}
+		return true
+	}
+
+	if sortsFirst(primary) {
+		return "", nil
+	}
+	prefix := "0-"
+	for i := 0; i < 8; i++ {
+		if sortsFirst(prefix + primary) {
+			return prefix + primary, nil
+		}
+		prefix = "0" + prefix
+	}
+	return "", fmt.Errorf("can't find a name for %s that sorts before the other files; choose one with --gist-file-name", primary)
+}
+
+// lowercaseGistNames adds renames that lowercase every gist file name not
+// already renamed. Names that still clash get -2, -3, ... in the order the
+// files are listed, so the result is the same on every publish.
+func lowercaseGistNames(gistFiles []string, renames map[string]string) {
+	taken := map[string]bool{}
+	for _, name := range renames {
+		taken[strings.ToLower(name)] = true
+	}
+
+	for _, file := range gistFiles {
+		local := filepath.Base(file)
+		if _, ok := renames[local]; ok {
+			continue
+		}
+
+		name := strings.ToLower(local)
+		ext := filepath.Ext(name)
+		stem := strings.TrimSuffix(name, ext)
+		for n := 2; taken[name]; n++ {
+			name = fmt.Sprintf("%s-%d%s", stem, n, ext)
+		}
+		taken[name] = true
+
+		if name != local {
+			renames[local] = name
+		}
+	}
+}
+
+// checkGistNameCase reports gist file names that differ only by case,
+// which GitHub rejects with an unhelpful error.
+func checkGistNameCase(gistFiles []string, renames map[string]string) error {
+	seen := map[string]string{}
+	for _, file := range gistFiles {
+		local := filepath.Base(file)
+		name := local
+		if renamed, ok := renames[local]; ok {
+			name = renamed
+		}
+
+		key := strings.ToLower(name)
+		other, clash := seen[key]
+		if clash && other != name {
+			return fmt.Errorf("gist file names %s and %s differ only by case, which gists don't allow; "+
+				"rename one (or use --gist-file-name), or publish with --lowercase-names", other, name)
+		}
+		seen[key] = name
+	}
+	return nil
+}
+
+// renameGistFiles copies files that have a gist name in renames to a temp
+// directory under that name. Every renamed file must exist and the
+// resulting gist names must be unique. The returned cleanup func is always
+// safe to call.
+func renameGistFiles(gistFiles []string, renames map[string]string) ([]string, func(), error) {
+	cleanup := func() {}
+	if len(renames) == 0 {
+		return gistFiles, cleanup, nil
+	}
+
+	found := map[string]bool{}
+	for _, file := range gistFiles {
+		if _, ok := renames[filepath.Base(file)]; ok {
+			found[filepath.Base(file)] = true
+		}
+	}
+	for local := range renames {
+		if !found[local] {
+			return nil, cleanup, fmt.Errorf("--gist-file-name: no file named %s in the post", local)
+		}
+	}
+
+	tmpDir, err := os.MkdirTemp("", "gblog-rename-")
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	cleanup = func() { os.RemoveAll(tmpDir) }
+
+	seen := map[string]string{}
+	result := make([]string, 0, len(gistFiles))
+	for _, file := range gistFiles {
+		local := filepath.Base(file)
+		name, ok := renames[local]
+		if !ok {
+			name = local
+		}
+		if other, dup := seen[name]; dup {
+			cleanup()
+			return nil, func() {}, fmt.Errorf("%s and %s would both be named %s in the gist", other, local, name)
+		}
+		seen[name] = local
+
+		if !ok {
+			result = append(result, file)
+			continue
+		}
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			cleanup()
+			return nil, func() {}, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		tmpPath := filepath.Join(tmpDir, name)
+		if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+			cleanup()
+			return nil, func() {}, fmt.Errorf("failed to write %s: %w", tmpPath, err)
+		}
+		result = append(result, tmpPath)
+	}
+
+	return result, cleanup, nil
 }
 
 func findPostDir(postID string) (string, error) {
+	// Accept unpadded IDs like "7" for "0007"
+	if normalized, err := normalizePostID(postID); err == nil {
+		postID = normalized
+	}
+
 	postsDir := "posts"
-	entries, err := os.ReadDir(postsDir)
+	dirs, err := listPostDirs(postsDir)
+	if os.IsNotExist(err) {
+		if _, statErr := os.Stat(".gblog/config.json"); os.IsNotExist(statErr) {
+			return "", errNotInitialized()
+		}
+	}
 	if err != nil {
 		return "", fmt.Errorf("failed to read posts directory: %w", err)
 	}
 
-	for _, entry := range entries {
-		if entry.IsDir() && strings.HasPrefix(entry.Name(), postID+"-") {
-			return filepath.Join(postsDir, entry.Name()), nil
+	for _, dir := range dirs {
+		if strings.HasPrefix(filepath.Base(dir), postID+"-") {
+			return filepath.Join(postsDir, dir), nil
 		}
 	}
 
-	return "", fmt.Errorf("post with ID %s not found", postID)
+	return "", withExitCode(ExitPostNotFound, fmt.Errorf("post with ID %s not found", postID))
 }
 
 func checkGHAuth() error {
 	cmd := exec.Command("gh", "auth", "status")
 	if err := cmd.Run(); err != nil {
-		fmt.Println("🔐 GitHub CLI authentication required.")
-		fmt.Println("Please run: gh auth login")
-		return fmt.Errorf("GitHub CLI not authenticated")
+		logWarn("🔐 GitHub CLI authentication required.")
+		logInfo("Please run: gh auth login")
+		return withExitCode(ExitAuthFailed, fmt.Errorf("GitHub CLI not authenticated"))
 	}
 	return nil
 }
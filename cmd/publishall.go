@@ -0,0 +1,92 @@
+// cmd/publishall.go
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// publishAllPublished updates the gist of every published post whose
+// files changed since its last publish, skipping the rest.
+func publishAllPublished(opts publishOptions) error {
+	// Check if gblog is initialized
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	posts, err := loadPosts()
+	if err != nil {
+		return err
+	}
+	posts, err = filterPosts(posts, postFilter{status: "published"})
+	if err != nil {
+		return err
+	}
+	if len(posts) == 0 {
+		return withExitCode(ExitNothingToDo, fmt.Errorf("no published posts to update"))
+	}
+	if err := checkGHAuth(); err != nil {
+		return err
+	}
+	if err := sortPosts(posts, "id"); err != nil {
+		return err
+	}
+	// Oldest first, in the order the posts were written
+	for i, j := 0, len(posts)-1; i < j; i, j = i+1, j-1 {
+		posts[i], posts[j] = posts[j], posts[i]
+	}
+
+	// One browser tab per post would be a lot
+	opts.noBrowser = true
+	opts.update = true
+
+	var updated, skipped int
+	var failed []string
+	for _, post := range posts {
+		switch {
+		case post.Meta.Anonymous:
+			logInfo(fmt.Sprintf("⏭️  %s %s (anonymous gist, can't be updated)", post.Meta.ID, post.Meta.Title), "post_id", post.Meta.ID)
+			skipped++
+			continue
+		case postBackend(post.Meta, config) != gistBackend:
+			logInfo(fmt.Sprintf("⏭️  %s %s (%s backend)", post.Meta.ID, post.Meta.Title, postBackend(post.Meta, config)), "post_id", post.Meta.ID)
+			skipped++
+			continue
+		}
+
+		changed, err := postModified(filepath.Join("posts", post.Dir), post.Meta)
+		if err != nil {
+			logWarnStderr(fmt.Sprintf("❌ %s: %v", post.Meta.ID, err), "post_id", post.Meta.ID)
+			failed = append(failed, post.Meta.ID)
+			continue
+		}
+		if !changed {
+			skipped++
+			continue
+		}
+
+		logInfo(fmt.Sprintf("\n🔄 %s %s", post.Meta.ID, post.Meta.Title), "post_id", post.Meta.ID)
+		if err := publishPost(post.Meta.ID, opts); err != nil {
+			logWarnStderr(fmt.Sprintf("❌ %s: %v", post.Meta.ID, err), "post_id", post.Meta.ID)
+			failed = append(failed, post.Meta.ID)
+			continue
+		}
+		updated++
+	}
+
+	if logger == nil {
+		fmt.Println()
+	}
+	logInfo(fmt.Sprintf("✅ Updated: %d | Unchanged/skipped: %d | Failed: %d", updated, skipped, len(failed)))
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to update: %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
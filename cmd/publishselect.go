@@ -0,0 +1,186 @@
+// cmd/publishselect.go
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/term"
+)
+
+// publishSelectModel is a checkbox list of posts to publish together.
+type publishSelectModel struct {
+	posts    []PostInfo
+	selected map[int]bool
+	cursor   int
+	done     bool
+	quitting bool
+}
+
+func (m publishSelectModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m publishSelectModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch key.String() {
+	case "ctrl+c", "esc", "q":
+		m.quitting = true
+		return m, tea.Quit
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(m.posts)-1 {
+			m.cursor++
+		}
+	case " ", "x":
+		m.selected[m.cursor] = !m.selected[m.cursor]
+	case "a":
+		// Select everything, or clear the selection if it already is
+		all := len(m.chosen()) == len(m.posts)
+		for i := range m.posts {
+			m.selected[i] = !all
+		}
+	case "enter":
+		m.done = true
+		return m, tea.Quit
+	}
+
+	return m, nil
+}
+
+func (m publishSelectModel) View() string {
+	if m.done || m.quitting {
+		return ""
+	}
+
+	var s strings.Builder
+
+	s.WriteString(titleStyle.Render("📤 Publish Posts"))
+	s.WriteString("\n")
+	s.WriteString("Choose the posts to publish:\n\n")
+
+	for i, post := range m.posts {
+		cursor := "  "
+		if i == m.cursor {
+			cursor = "> "
+		}
+		box := "[ ]"
+		if m.selected[i] {
+			box = "[x]"
+		}
+
+		status := draftColor.Render("draft")
+		if post.Meta.RemoteID != "" {
+			status = publishedColor.Render("modified")
+		}
+		visibility := ""
+		if !post.Meta.Public {
+			visibility = " " + privateColor.Render("private")
+		}
+
+		s.WriteString(fmt.Sprintf("%s%s %s %s (%s%s)\n", cursor, box, post.Meta.ID, post.Meta.Title, status, visibility))
+	}
+
+	s.WriteString(fmt.Sprintf("\n%d selected\n\n", len(m.chosen())))
+	s.WriteString(helpStyle.Render("↑/↓ move • space select • a all • enter publish • esc cancel"))
+
+	return s.String()
+}
+
+// chosen returns the selected posts in list order.
+func (m publishSelectModel) chosen() []PostInfo {
+	var posts []PostInfo
+	for i, post := range m.posts {
+		if m.selected[i] {
+			posts = append(posts, post)
+		}
+	}
+	return posts
+}
+
+// publishSelected lets the user pick drafts and modified posts in a
+// checkbox list, then publishes (or updates) each one in turn.
+func publishSelected(opts publishOptions) error {
+	// Check if gblog is initialized
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+
+	if !term.IsTerminal(os.Stdin.Fd()) || !term.IsTerminal(os.Stdout.Fd()) {
+		return fmt.Errorf("selecting posts needs a terminal; pass a post ID instead")
+	}
+
+	posts, err := loadPosts()
+	if err != nil {
+		return err
+	}
+	posts, err = filterPosts(posts, postFilter{modified: true})
+	if err != nil {
+		return err
+	}
+	if err := sortPosts(posts, "id"); err != nil {
+		return err
+	}
+
+	if len(posts) == 0 {
+		fmt.Println("Nothing to publish: there are no drafts or modified posts.")
+		return nil
+	}
+
+	finalModel, err := tea.NewProgram(publishSelectModel{
+		posts:    posts,
+		selected: map[int]bool{},
+	}).Run()
+	if err != nil {
+		return err
+	}
+
+	m := finalModel.(publishSelectModel)
+	chosen := m.chosen()
+	if m.quitting || len(chosen) == 0 {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	return publishEach(chosen, opts)
+}
+
+// publishEach publishes or updates each post in turn, according to
+// whether it has a gist yet, and reports the ones that failed.
+func publishEach(chosen []PostInfo, opts publishOptions) error {
+	// One browser tab per post would be a lot; list the URLs instead
+	opts.noBrowser = true
+
+	var failed []string
+	for i, post := range chosen {
+		if logger != nil {
+			logger.Info("publishing selected post", "post_id", post.Meta.ID, "index", i+1, "count", len(chosen))
+		} else {
+			fmt.Printf("\n[%d/%d] %s %s\n", i+1, len(chosen), post.Meta.ID, post.Meta.Title)
+		}
+		postOpts := opts
+		postOpts.update = post.Meta.RemoteID != ""
+		if err := publishPost(post.Meta.ID, postOpts); err != nil {
+			logWarnStderr(fmt.Sprintf("❌ %s: %v", post.Meta.ID, err), "post_id", post.Meta.ID)
+			failed = append(failed, post.Meta.ID)
+		}
+	}
+
+	if logger == nil {
+		fmt.Println()
+	}
+	logInfo(fmt.Sprintf("✅ Published %d of %d post(s)", len(chosen)-len(failed), len(chosen)))
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to publish: %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
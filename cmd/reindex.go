@@ -0,0 +1,114 @@
+// cmd/reindex.go
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var reindexCmd = &cobra.Command{
+	Use:   "reindex [post-id]",
+	Short: "Rename post directories to match their current titles",
+	Long: `Rename post directories and markdown files to match their current titles.
+
+After editing a title in .meta.json, the directory slug and the markdown
+file named after it go stale. This re-slugifies the title and renames
+both, updating any .gitignore entry. Inside a git repository files are
+moved with 'git mv' to keep history. Posts already in canonical form
+are left alone.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+			return errNotInitialized()
+		}
+
+		var postDirs []string
+		if len(args) == 1 {
+			postDir, err := findPostDir(args[0])
+			if err != nil {
+				return err
+			}
+			postDirs = append(postDirs, postDir)
+		} else {
+			posts, err := loadPosts()
+			if err != nil {
+				return err
+			}
+			for _, post := range posts {
+				postDirs = append(postDirs, filepath.Join("posts", post.Dir))
+			}
+		}
+
+		renamed := 0
+		for _, postDir := range postDirs {
+			changed, err := reindexPost(postDir)
+			if err != nil {
+				return err
+			}
+			if changed {
+				renamed++
+			}
+		}
+
+		if renamed == 0 {
+			logInfo("All posts are already up to date.")
+			return nil
+		}
+		logInfo(fmt.Sprintf("✅ Reindexed %d post(s)", renamed))
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(reindexCmd)
+}
+
+// reindexPost renames a post directory, and its markdown file when it is
+// named after the slug, to match the title. It reports whether anything
+// was renamed.
+func reindexPost(postDir string) (bool, error) {
+	meta, err := loadPostMeta(postDir)
+	if err != nil {
+		return false, err
+	}
+
+	newSlug := slugify(meta.Title)
+	if newSlug == "" {
+		logWarn(fmt.Sprintf("⚠️  Skipping %s: title %q has no usable characters", postDir, meta.Title))
+		return false, nil
+	}
+
+	prefix, oldSlug, _ := strings.Cut(filepath.Base(postDir), "-")
+	if oldSlug == newSlug {
+		return false, nil
+	}
+
+	// Rename the markdown file first, while its directory path is known.
+	// Custom file names (e.g. snippet.py) are kept as they are.
+	oldFile := filepath.Join(postDir, oldSlug+".md")
+	if _, err := os.Stat(oldFile); err == nil {
+		newFile := filepath.Join(postDir, newSlug+".md")
+		if err := renamePostDir(oldFile, newFile); err != nil {
+			return false, err
+		}
+		logInfo(fmt.Sprintf("📄 %s → %s", oldFile, newFile))
+	}
+
+	newDir := filepath.Join(filepath.Dir(postDir), fmt.Sprintf("%s-%s", prefix, newSlug))
+	if err := renamePostDir(postDir, newDir); err != nil {
+		return false, err
+	}
+	logInfo(fmt.Sprintf("📁 %s → %s", postDir, newDir))
+
+	if err := replaceGitignoreEntry(postDir, newDir); err != nil {
+		logWarn(fmt.Sprintf("Warning: could not update .gitignore: %v", err))
+	}
+
+	recordHistory("reindex", meta.ID, meta.RemoteID, fmt.Sprintf("%s → %s", filepath.Base(postDir), filepath.Base(newDir)))
+
+	return true, nil
+}
@@ -0,0 +1,87 @@
+// cmd/rename.go
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var renameCmd = &cobra.Command{
+	Use:   "rename <post-id> --slug <new-slug>",
+	Short: "Change a post's slug",
+	Long: `Change the slug of a post's directory without touching its title.
+
+The new slug is cleaned up like a generated one (lowercase letters,
+digits, and hyphens). The post directory is renamed, with 'git mv' when
+it is tracked, and so is its main <slug>.md file; a published post's
+gist gets the file under the new name. Private posts keep their
+.gitignore entry.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !cmd.Flags().Changed("slug") {
+			return fmt.Errorf("--slug is required")
+		}
+		slug, _ := cmd.Flags().GetString("slug")
+		return renamePostSlug(args[0], slug)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(renameCmd)
+	renameCmd.Flags().String("slug", "", "New slug for the post directory and main file")
+}
+
+func renamePostSlug(postID, newSlug string) error {
+	slug := slugify(newSlug)
+	if slug == "" {
+		return fmt.Errorf("invalid slug %q: needs at least one letter or digit", newSlug)
+	}
+
+	postDir, err := findPostDir(postID)
+	if err != nil {
+		return err
+	}
+
+	meta, err := loadPostMeta(postDir)
+	if err != nil {
+		return err
+	}
+
+	id, oldSlug, _ := strings.Cut(filepath.Base(postDir), "-")
+	if oldSlug == slug {
+		logInfo(fmt.Sprintf("Post %s already has slug %s", meta.ID, slug), "post_id", meta.ID)
+		return nil
+	}
+
+	newDir := filepath.Join(filepath.Dir(postDir), fmt.Sprintf("%s-%s", id, slug))
+	if _, err := os.Stat(newDir); err == nil {
+		return fmt.Errorf("%s already exists", newDir)
+	}
+
+	if err := renamePostDir(postDir, newDir); err != nil {
+		return err
+	}
+
+	if err := replaceGitignoreEntry(postDir, newDir); err != nil {
+		logWarn(fmt.Sprintf("Warning: could not update .gitignore: %v", err))
+	}
+
+	recordHistory("rename", meta.ID, meta.RemoteID, fmt.Sprintf("%s → %s", oldSlug, slug))
+	logInfo(fmt.Sprintf("📁 %s → %s", postDir, newDir))
+
+	// The main file is named after the slug; rename it (and its gist
+	// copy) to match
+	oldFile := oldSlug + ".md"
+	if _, err := os.Stat(filepath.Join(newDir, oldFile)); err == nil {
+		if err := renamePostFile(meta.ID, oldFile, slug+".md"); err != nil {
+			return err
+		}
+	}
+
+	logInfo(fmt.Sprintf("✅ Renamed post %s to slug %s", meta.ID, slug), "post_id", meta.ID)
+	return nil
+}
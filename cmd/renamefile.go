@@ -0,0 +1,103 @@
+// cmd/renamefile.go
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+var renameFileCmd = &cobra.Command{
+	Use:   "rename-file <post-id> <old-name> <new-name>",
+	Short: "Rename a file in a post and its gist",
+	Long: `Rename a file inside a post directory (with 'git mv' when it is
+tracked). If the post is published, the gist gets the file under its new
+name and the old name is removed, so the two stay consistent.`,
+	Args: cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return renamePostFile(args[0], args[1], args[2])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(renameFileCmd)
+}
+
+func renamePostFile(postID, oldName, newName string) error {
+	postDir, err := findPostDir(postID)
+	if err != nil {
+		return err
+	}
+
+	meta, err := loadPostMeta(postDir)
+	if err != nil {
+		return err
+	}
+
+	if err := validatePostFilename(newName); err != nil {
+		return err
+	}
+	if oldName == ".meta.json" {
+		return fmt.Errorf(".meta.json can't be renamed")
+	}
+
+	oldPath, err := namedPostFile(postDir, oldName)
+	if err != nil {
+		return err
+	}
+	newPath := filepath.Join(postDir, newName)
+
+	if err := renamePostDir(oldPath, newPath); err != nil {
+		return err
+	}
+	logInfo(fmt.Sprintf("📄 %s → %s", oldPath, newPath))
+
+	recordHistory("rename-file", meta.ID, meta.RemoteID, fmt.Sprintf("%s → %s", oldName, newName))
+
+	if meta.RemoteID == "" || meta.Anonymous {
+		return nil
+	}
+
+	// Files kept out of the gist have nothing to rename there
+	gistFiles, err := getGistFiles(postDir)
+	if err != nil {
+		return err
+	}
+	inGist := false
+	for _, file := range gistFiles {
+		if file == newPath {
+			inGist = true
+		}
+	}
+	if !inGist {
+		return nil
+	}
+
+	if err := checkGHAuth(); err != nil {
+		return err
+	}
+
+	content, err := os.ReadFile(newPath)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", newPath, err)
+	}
+
+	// Add the new name and delete the old one in a single update
+	files := map[string]*gistFile{
+		filepath.Base(newName): {Content: string(content)},
+		filepath.Base(oldName): nil,
+	}
+	revision, err := patchGist(meta.RemoteID, nil, files)
+	if err != nil {
+		return fmt.Errorf("renamed locally, but failed to update the gist: %w", err)
+	}
+	meta.RemoteRevision = revision
+	if err := savePostMeta(postDir, meta); err != nil {
+		return err
+	}
+	logInfo(fmt.Sprintf("✅ Renamed %s → %s in gist %s", oldName, newName, meta.RemoteID))
+
+	return nil
+}
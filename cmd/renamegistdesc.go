@@ -0,0 +1,76 @@
+// cmd/renamegistdesc.go
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var renameGistDescCmd = &cobra.Command{
+	Use:   "rename-gist-desc <post-id> <description>",
+	Short: "Change a post's description and its gist's",
+	Long: `Set a post's description and update just the description of its
+gist, without uploading any files. Unlike 'publish --update', this
+doesn't add a file revision to the gist.
+
+For a post that isn't published yet only .meta.json is updated.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return renameGistDescription(args[0], args[1])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(renameGistDescCmd)
+}
+
+func renameGistDescription(postID, description string) error {
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return fmt.Errorf("description cannot be empty")
+	}
+
+	postDir, err := findPostDir(postID)
+	if err != nil {
+		return err
+	}
+
+	meta, err := loadPostMeta(postDir)
+	if err != nil {
+		return err
+	}
+
+	if meta.RemoteID != "" {
+		if meta.Anonymous {
+			return fmt.Errorf("post %s was published anonymously and its gist can't be edited", meta.ID)
+		}
+		if err := checkGHAuth(); err != nil {
+			return err
+		}
+
+		revision, err := patchGist(meta.RemoteID, &description, nil)
+		if err != nil {
+			return fmt.Errorf("failed to update gist description: %w", err)
+		}
+		if revision != "" {
+			meta.RemoteRevision = revision
+		}
+	}
+
+	previous := meta.Description
+	meta.Description = description
+	if err := savePostMeta(postDir, meta); err != nil {
+		return err
+	}
+
+	recordHistory("rename-gist-desc", meta.ID, meta.RemoteID, fmt.Sprintf("%q → %q", previous, description))
+
+	if meta.RemoteID == "" {
+		logInfo(fmt.Sprintf("✅ Updated the description of post %s (not published yet)", meta.ID), "post_id", meta.ID)
+		return nil
+	}
+	logInfo(fmt.Sprintf("✅ Updated the description of gist %s", meta.RemoteID))
+	return nil
+}
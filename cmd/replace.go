@@ -0,0 +1,100 @@
+// cmd/replace.go
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+var replaceCmd = &cobra.Command{
+	Use:   "replace <post-id> <file>",
+	Short: "Replace a post's content with a file",
+	Long: `Overwrite a post's main markdown file with the contents of file, or
+of stdin when file is '-'. The post's metadata is left as it is.
+
+The previous content is kept as a hidden .<name>.bak next to it (it is
+never published) unless --no-backup is given. With --publish, the post
+is published, or its gist updated, right after.
+
+  gblog replace 0007 ~/Drafts/final.md --publish`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		noBackup, _ := cmd.Flags().GetBool("no-backup")
+		publish, _ := cmd.Flags().GetBool("publish")
+		return replacePostContent(args[0], args[1], !noBackup, publish)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(replaceCmd)
+	replaceCmd.Flags().Bool("no-backup", false, "Don't keep a .bak copy of the replaced content")
+	replaceCmd.Flags().Bool("publish", false, "Publish (or update) the post afterwards")
+}
+
+func replacePostContent(postID, source string, backup, publish bool) error {
+	postDir, err := findPostDir(postID)
+	if err != nil {
+		return err
+	}
+
+	meta, err := loadPostMeta(postDir)
+	if err != nil {
+		return err
+	}
+
+	postFile, err := primaryPostFile(postDir)
+	if err != nil {
+		return err
+	}
+
+	var content []byte
+	if source == "-" {
+		content, err = io.ReadAll(os.Stdin)
+	} else {
+		content, err = os.ReadFile(source)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read new content: %w", err)
+	}
+	if len(bytes.TrimSpace(content)) == 0 {
+		return fmt.Errorf("refusing to replace %s with empty content", filepath.Base(postFile))
+	}
+
+	previous, err := os.ReadFile(postFile)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", postFile, err)
+	}
+
+	if bytes.Equal(previous, content) {
+		logInfo(fmt.Sprintf("💡 %s already has this content", postFile))
+	} else {
+		if backup {
+			backupPath := filepath.Join(postDir, "."+filepath.Base(postFile)+".bak")
+			if err := os.WriteFile(backupPath, previous, 0644); err != nil {
+				return fmt.Errorf("failed to back up %s: %w", postFile, err)
+			}
+			logInfo(fmt.Sprintf("💾 Backed up the previous content to %s", backupPath))
+		}
+
+		if err := os.WriteFile(postFile, content, 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", postFile, err)
+		}
+		recordHistory("replace", meta.ID, meta.RemoteID, source)
+		logInfo(fmt.Sprintf("✅ Replaced %s", postFile))
+	}
+
+	if !publish {
+		logInfo(fmt.Sprintf("💡 Run 'gblog publish %s' when ready", meta.ID), "post_id", meta.ID)
+		return nil
+	}
+
+	if logger == nil {
+		fmt.Println()
+	}
+	return publishPost(meta.ID, publishOptions{update: meta.RemoteID != ""})
+}
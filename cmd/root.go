@@ -3,12 +3,16 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
-var cfgFile string
+var (
+	cfgFile string
+	workDir string
+)
 
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
@@ -18,18 +22,35 @@ var rootCmd = &cobra.Command{
 
 Write your posts in markdown, add auxiliary files, and publish them as gists.
 Your blog becomes a collection of organized, shareable code snippets and thoughts.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		setupLogging(cmd)
+
+		// Like 'git -C', resolve everything relative to another directory
+		if workDir != "" {
+			if err := os.Chdir(workDir); err != nil {
+				return fmt.Errorf("cannot change to %s: %w", workDir, err)
+			}
+		}
+		initConfig()
+
+		// Per-project settings such as a GITHUB_TOKEN for this blog
+		return loadProjectEnv()
+	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 func Execute() error {
-	return rootCmd.Execute()
+	start := time.Now()
+	err := rootCmd.Execute()
+	logCommandResult(err, time.Since(start))
+	return err
 }
 
 func init() {
-	cobra.OnInitialize(initConfig)
-
 	// Global flags
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is .gblog/config.json)")
+	rootCmd.PersistentFlags().BoolVar(&logJSON, "log-json", false, "write progress messages as JSON log records on stderr (reports such as list tables still print on stdout)")
+	rootCmd.PersistentFlags().StringVarP(&workDir, "cwd", "C", "", "run as if gblog was started in this directory")
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -45,6 +66,15 @@ func initConfig() {
 	viper.AutomaticEnv()
 
 	if err := viper.ReadInConfig(); err == nil {
-		fmt.Fprintln(os.Stderr, "Using config file:", viper.ConfigFileUsed())
+		if logger != nil {
+			logger.Info("using config file", "path", viper.ConfigFileUsed())
+		} else {
+			fmt.Fprintln(os.Stderr, "Using config file:", viper.ConfigFileUsed())
+		}
+	}
+
+	// Apply theme colors from the project config, if any
+	if config, err := loadConfig(); err == nil {
+		applyTheme(config.Theme)
 	}
 }
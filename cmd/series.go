@@ -0,0 +1,153 @@
+// cmd/series.go
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var seriesCmd = &cobra.Command{
+	Use:   "series [name]",
+	Short: "List series or the posts in a series",
+	Long: `List all series with their post counts, or, given a series name,
+show that series' posts in reading order with previous/next links.
+
+Add posts to a series with 'gblog new --series <name>'.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) > 0 {
+			return showSeries(args[0])
+		}
+		return listSeries()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(seriesCmd)
+}
+
+func listSeries() error {
+	// Check if gblog is initialized
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+
+	posts, err := loadPosts()
+	if err != nil {
+		return err
+	}
+
+	// Series names match regardless of case, as in 'series <name>'; show
+	// the first spelling seen
+	counts := map[string]int{}
+	spellings := map[string]string{}
+	for _, post := range posts {
+		if post.Meta.Series == "" {
+			continue
+		}
+		key := strings.ToLower(post.Meta.Series)
+		if _, ok := spellings[key]; !ok {
+			spellings[key] = post.Meta.Series
+		}
+		counts[key]++
+	}
+
+	if len(counts) == 0 {
+		fmt.Println("No series found. Start one with 'gblog new --series <name>'")
+		return nil
+	}
+
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fmt.Println(listTitleStyle.Render("📚 Series"))
+	fmt.Println()
+	for _, key := range keys {
+		fmt.Printf("%-40s %d posts\n", spellings[key], counts[key])
+	}
+
+	return nil
+}
+
+func showSeries(name string) error {
+	// Check if gblog is initialized
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+
+	posts, err := loadPosts()
+	if err != nil {
+		return err
+	}
+
+	parts := seriesPosts(posts, name)
+	if len(parts) == 0 {
+		return fmt.Errorf("series %q not found", name)
+	}
+
+	fmt.Println(listTitleStyle.Render(fmt.Sprintf("📚 %s", parts[0].Meta.Series)))
+	fmt.Println()
+	for i, post := range parts {
+		status := draftColor.Render("Draft")
+		if post.Meta.RemoteID != "" {
+			status = publishedColor.Render("Published")
+		}
+		fmt.Printf("%3d. %s  %-40s %s\n", post.Meta.SeriesOrder, post.Meta.ID, post.Meta.Title, status)
+
+		var links []string
+		if i > 0 {
+			links = append(links, "← "+parts[i-1].Meta.ID)
+		}
+		if i < len(parts)-1 {
+			links = append(links, "→ "+parts[i+1].Meta.ID)
+		}
+		if len(links) > 0 {
+			fmt.Println(helpStyle.UnsetMargins().Render("     " + strings.Join(links, "  ")))
+		}
+	}
+
+	return nil
+}
+
+// seriesPosts returns the posts in the named series (case-insensitive),
+// ordered by SeriesOrder then ID.
+func seriesPosts(posts []PostInfo, name string) []PostInfo {
+	var parts []PostInfo
+	for _, post := range posts {
+		if post.Meta.Series != "" && strings.EqualFold(post.Meta.Series, name) {
+			parts = append(parts, post)
+		}
+	}
+
+	sort.Slice(parts, func(i, j int) bool {
+		if parts[i].Meta.SeriesOrder != parts[j].Meta.SeriesOrder {
+			return parts[i].Meta.SeriesOrder < parts[j].Meta.SeriesOrder
+		}
+		return parts[i].Meta.ID < parts[j].Meta.ID
+	})
+
+	return parts
+}
+
+// nextSeriesOrder returns the position after the last post in a series.
+func nextSeriesOrder(name string) int {
+	posts, err := loadPosts()
+	if err != nil {
+		return 1
+	}
+
+	next := 1
+	for _, post := range seriesPosts(posts, name) {
+		if post.Meta.SeriesOrder >= next {
+			next = post.Meta.SeriesOrder + 1
+		}
+	}
+	return next
+}
@@ -0,0 +1,281 @@
+// cmd/spellcheck.go
+package cmd
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// embeddedDictionary lists English words, most common first.
+//
+//go:embed dictionary.txt
+var embeddedDictionary string
+
+// customDictionaryPath holds project-specific words, one per line.
+const customDictionaryPath = ".gblog/dictionary.txt"
+
+var spellcheckCmd = &cobra.Command{
+	Use:   "spellcheck [post-id]",
+	Short: "Check a post for spelling mistakes",
+	Long: `Check a post's markdown for spelling mistakes.
+
+Words are checked against a built-in English dictionary plus the custom
+words in .gblog/dictionary.txt. Code blocks, inline code, and URLs are
+ignored. Use --add to teach gblog a new word.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+			return errNotInitialized()
+		}
+
+		words, _ := cmd.Flags().GetStringSlice("add")
+		if len(words) > 0 {
+			if err := addDictionaryWords(words); err != nil {
+				return err
+			}
+			if len(args) == 0 {
+				return nil
+			}
+		}
+
+		if len(args) == 0 {
+			return fmt.Errorf("requires a post ID (or --add <word>)")
+		}
+		return spellcheckPost(args[0])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(spellcheckCmd)
+	spellcheckCmd.Flags().StringSlice("add", nil, "Add a word to the custom dictionary (repeatable)")
+}
+
+// misspelling is an unknown word and where it appears.
+type misspelling struct {
+	line        int
+	word        string
+	suggestions []string
+}
+
+var (
+	inlineCodePattern = regexp.MustCompile("`[^`]*`")
+	urlPattern        = regexp.MustCompile(`(https?://|www\.)\S+|\]\([^)]*\)|<[^>]+>`)
+	wordPattern       = regexp.MustCompile(`[A-Za-z]+(?:'[A-Za-z]+)*`)
+)
+
+func spellcheckPost(postID string) error {
+	postDir, err := findPostDir(postID)
+	if err != nil {
+		return err
+	}
+
+	file, err := primaryPostFile(postDir)
+	if err != nil {
+		return err
+	}
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", file, err)
+	}
+
+	dict, err := loadDictionary()
+	if err != nil {
+		return err
+	}
+
+	mistakes := findMisspellings(string(content), dict)
+	if len(mistakes) == 0 {
+		fmt.Printf("✅ No spelling mistakes found in %s\n", file)
+		return nil
+	}
+
+	fmt.Printf("📝 %d possible misspelling(s) in %s:\n\n", len(mistakes), file)
+	for _, m := range mistakes {
+		if len(m.suggestions) > 0 {
+			fmt.Printf("  line %d: %s → %s\n", m.line, m.word, strings.Join(m.suggestions, ", "))
+		} else {
+			fmt.Printf("  line %d: %s\n", m.line, m.word)
+		}
+	}
+	fmt.Println("\nAdd valid words with: gblog spellcheck --add <word>")
+
+	return nil
+}
+
+// loadDictionary combines the built-in word list with the project's
+// custom dictionary, mapping each word to its frequency rank.
+func loadDictionary() (map[string]int, error) {
+	dict := map[string]int{}
+	for i, word := range strings.Fields(embeddedDictionary) {
+		dict[word] = i + 1
+	}
+
+	data, err := os.ReadFile(customDictionaryPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read %s: %w", customDictionaryPath, err)
+	}
+	for _, word := range strings.Fields(string(data)) {
+		if word = strings.ToLower(word); dict[word] == 0 {
+			dict[word] = len(dict) + 1
+		}
+	}
+
+	return dict, nil
+}
+
+// addDictionaryWords appends words to the custom dictionary, skipping
+// ones it already contains.
+func addDictionaryWords(words []string) error {
+	dict, err := loadDictionary()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(customDictionaryPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", customDictionaryPath, err)
+	}
+	defer f.Close()
+
+	for _, word := range words {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if word == "" || dict[word] > 0 {
+			fmt.Printf("'%s' is already in the dictionary\n", word)
+			continue
+		}
+		if _, err := fmt.Fprintln(f, word); err != nil {
+			return fmt.Errorf("failed to write %s: %w", customDictionaryPath, err)
+		}
+		dict[word] = len(dict) + 1
+		fmt.Printf("✅ Added '%s' to %s\n", word, customDictionaryPath)
+	}
+
+	return nil
+}
+
+// findMisspellings returns the unknown words in markdown content,
+// ignoring fenced code blocks, inline code, and links.
+func findMisspellings(content string, dict map[string]int) []misspelling {
+	var mistakes []misspelling
+	inFence := false
+
+	for i, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		line = inlineCodePattern.ReplaceAllString(line, " ")
+		line = urlPattern.ReplaceAllString(line, " ")
+
+		for _, word := range wordPattern.FindAllString(line, -1) {
+			if isKnownWord(word, dict) {
+				continue
+			}
+			mistakes = append(mistakes, misspelling{
+				line:        i + 1,
+				word:        word,
+				suggestions: suggestWords(strings.ToLower(word), dict),
+			})
+		}
+	}
+
+	return mistakes
+}
+
+// isKnownWord reports whether word, or a simple inflection of it, is in
+// the dictionary. Acronyms and camelCase identifiers are always accepted.
+func isKnownWord(word string, dict map[string]int) bool {
+	if len(word) < 2 || strings.ToLower(word[1:]) != word[1:] {
+		return true
+	}
+
+	// Check the part before an apostrophe: "don't", "post's"
+	word, _, _ = strings.Cut(strings.ToLower(word), "'")
+	if dict[word] > 0 {
+		return true
+	}
+
+	for _, rule := range [][2]string{
+		{"ies", "y"}, {"ied", "y"}, {"es", ""}, {"s", ""}, {"ed", ""}, {"ed", "e"},
+		{"d", ""}, {"ing", ""}, {"ing", "e"}, {"ly", ""}, {"er", ""}, {"est", ""},
+	} {
+		if stem, ok := strings.CutSuffix(word, rule[0]); ok && len(stem) > 1 && dict[stem+rule[1]] > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// suggestWords returns up to three dictionary words within two edits
+// of word, closest and then most common first.
+func suggestWords(word string, dict map[string]int) []string {
+	type candidate struct {
+		word     string
+		distance int
+		rank     int
+	}
+
+	var candidates []candidate
+	for entry, rank := range dict {
+		if diff := len(entry) - len(word); diff > 2 || diff < -2 {
+			continue
+		}
+		if d := editDistance(word, entry); d <= 2 {
+			candidates = append(candidates, candidate{entry, d, rank})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].rank < candidates[j].rank
+	})
+
+	var suggestions []string
+	for i := 0; i < len(candidates) && i < 3; i++ {
+		suggestions = append(suggestions, candidates[i].word)
+	}
+	return suggestions
+}
+
+// editDistance is the edit distance between a and b, counting an
+// adjacent transposition ("teh" for "the") as a single edit.
+func editDistance(a, b string) int {
+	rows := make([][]int, len(a)+1)
+	for i := range rows {
+		rows[i] = make([]int, len(b)+1)
+		rows[i][0] = i
+	}
+	for j := range rows[0] {
+		rows[0][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			rows[i][j] = min(rows[i-1][j]+1, rows[i][j-1]+1, rows[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				rows[i][j] = min(rows[i][j], rows[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return rows[len(a)][len(b)]
+}
@@ -0,0 +1,126 @@
+// cmd/star.go
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// starCachePath caches whether each gist is starred.
+const starCachePath = ".gblog/cache/stars.json"
+
+// starCacheTTL is how long a cached star status is trusted.
+const starCacheTTL = time.Hour
+
+var gistStarCmd = &cobra.Command{
+	Use:   "star <post-id>",
+	Short: "Star the gist of a published post",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setGistStar(args[0], true)
+	},
+}
+
+var gistUnstarCmd = &cobra.Command{
+	Use:   "unstar <post-id>",
+	Short: "Remove the star from a published post's gist",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setGistStar(args[0], false)
+	},
+}
+
+// starStatus is a cached star check for one gist.
+type starStatus struct {
+	Starred   bool      `json:"starred"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+func init() {
+	gistCmd.AddCommand(gistStarCmd)
+	gistCmd.AddCommand(gistUnstarCmd)
+}
+
+func setGistStar(postID string, star bool) error {
+	postDir, err := findPostDir(postID)
+	if err != nil {
+		return err
+	}
+
+	meta, err := loadPostMeta(postDir)
+	if err != nil {
+		return err
+	}
+
+	if meta.RemoteID == "" {
+		return fmt.Errorf("post %s has not been published", meta.ID)
+	}
+
+	if err := checkGHAuth(); err != nil {
+		return err
+	}
+
+	method := "PUT"
+	if !star {
+		method = "DELETE"
+	}
+	if _, err := ghAPI(nil, "-X", method, fmt.Sprintf("gists/%s/star", meta.RemoteID)); err != nil {
+		return fmt.Errorf("failed to update star: %w", err)
+	}
+
+	cache := loadStarCache()
+	cache[meta.RemoteID] = starStatus{Starred: star, CheckedAt: time.Now()}
+	saveStarCache(cache)
+
+	if star {
+		logInfo(fmt.Sprintf("⭐ Starred %s: %s", meta.ID, meta.RemoteURL), "post_id", meta.ID)
+	} else {
+		logInfo(fmt.Sprintf("✅ Unstarred %s: %s", meta.ID, meta.RemoteURL), "post_id", meta.ID)
+	}
+	return nil
+}
+
+// isGistStarred reports whether the authenticated user starred a gist,
+// using the cache when the last check is recent. GitHub answers 204 for
+// starred gists and 404 otherwise.
+func isGistStarred(gistID string, cache map[string]starStatus) (bool, error) {
+	if status, ok := cache[gistID]; ok && time.Since(status.CheckedAt) < starCacheTTL {
+		return status.Starred, nil
+	}
+
+	_, err := ghAPI(nil, fmt.Sprintf("gists/%s/star", gistID))
+	if err != nil && !isNotFound(err) {
+		return false, err
+	}
+
+	starred := err == nil
+	cache[gistID] = starStatus{Starred: starred, CheckedAt: time.Now()}
+	return starred, nil
+}
+
+// loadStarCache reads the star cache, treating a missing or unreadable
+// file as empty.
+func loadStarCache() map[string]starStatus {
+	cache := map[string]starStatus{}
+	if data, err := os.ReadFile(starCachePath); err == nil {
+		json.Unmarshal(data, &cache)
+	}
+	return cache
+}
+
+// saveStarCache writes the star cache. A failed write only costs a
+// recheck next time, so it is reported as a warning.
+func saveStarCache(cache map[string]starStatus) {
+	err := os.MkdirAll(filepath.Dir(starCachePath), 0755)
+	if err == nil {
+		err = writeJSONAtomic(starCachePath, cache)
+	}
+	if err != nil {
+		logWarnStderr(fmt.Sprintf("Warning: failed to cache stars: %v", err))
+	}
+}
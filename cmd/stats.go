@@ -0,0 +1,179 @@
+// cmd/stats.go
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show blog statistics",
+	Long: `Show counts of posts by status and visibility, tags, series, and words.
+
+The same filters as 'gblog list' apply. --format picks the output:
+text (default, styled for the terminal), json for scripts, or markdown
+for a table to paste into a README.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, _ := cmd.Flags().GetString("format")
+		filter, err := readFilterFlags(cmd)
+		if err != nil {
+			return err
+		}
+		return showStats(filter, format)
+	},
+}
+
+// blogStats are the metrics reported by stats.
+type blogStats struct {
+	Total         int        `json:"total"`
+	Published     int        `json:"published"`
+	Drafts        int        `json:"drafts"`
+	Public        int        `json:"public"`
+	Private       int        `json:"private"`
+	Tags          int        `json:"tags"`
+	Series        int        `json:"series"`
+	Words         int        `json:"words"`
+	LastPublished *time.Time `json:"last_published,omitempty"`
+}
+
+func init() {
+	rootCmd.AddCommand(statsCmd)
+	addFilterFlags(statsCmd)
+	statsCmd.Flags().String("format", "text", "Output format: text, json, or markdown")
+}
+
+func showStats(filter postFilter, format string) error {
+	// Check if gblog is initialized
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+
+	switch format {
+	case "text", "json", "markdown":
+	default:
+		return fmt.Errorf("invalid format %q (use text, json, or markdown)", format)
+	}
+
+	posts, err := loadPosts()
+	if err != nil {
+		return err
+	}
+
+	posts, err = filterPosts(posts, filter)
+	if err != nil {
+		return err
+	}
+
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	dates := newDateFormatter(config, false)
+
+	stats := collectStats(posts)
+
+	switch format {
+	case "json":
+		data, err := json.MarshalIndent(stats, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode stats: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	case "markdown":
+		fmt.Print(renderStatsMarkdown(stats, dates))
+		return nil
+	}
+
+	countStyle := lipgloss.NewStyle().Bold(true).Foreground(inputStyle.GetBorderTopForeground())
+	fmt.Println(listTitleStyle.Render("📊 Blog Stats"))
+	for _, row := range statsRows(stats, dates) {
+		fmt.Printf("%-16s %s\n", row[0], countStyle.Render(row[1]))
+	}
+	fmt.Println()
+
+	return nil
+}
+
+// collectStats computes the metrics for posts.
+func collectStats(posts []PostInfo) blogStats {
+	stats := blogStats{Total: len(posts)}
+	series := map[string]bool{}
+	for _, post := range posts {
+		if post.Meta.RemoteID != "" {
+			stats.Published++
+			if published := post.Meta.PublishedAt; published != nil && (stats.LastPublished == nil || published.After(*stats.LastPublished)) {
+				stats.LastPublished = published
+			}
+		}
+		if post.Meta.Public {
+			stats.Public++
+		}
+		if post.Meta.Series != "" {
+			series[strings.ToLower(post.Meta.Series)] = true
+		}
+		stats.Words += postWordCount(filepath.Join("posts", post.Dir))
+	}
+	stats.Drafts = stats.Total - stats.Published
+	stats.Private = stats.Total - stats.Public
+	stats.Tags = len(countTags(posts))
+	stats.Series = len(series)
+	return stats
+}
+
+// postWordCount counts the words in a post's markdown files.
+func postWordCount(postDir string) int {
+	files, err := getGistFiles(postDir)
+	if err != nil {
+		return 0
+	}
+	words := 0
+	for _, file := range files {
+		if strings.ToLower(filepath.Ext(file)) != ".md" {
+			continue
+		}
+		if data, err := os.ReadFile(file); err == nil {
+			words += len(strings.Fields(string(data)))
+		}
+	}
+	return words
+}
+
+// statsRows returns the metrics as label/value pairs in display order.
+func statsRows(stats blogStats, dates dateFormatter) [][2]string {
+	lastPublished := "never"
+	if stats.LastPublished != nil {
+		lastPublished = dates.Format(*stats.LastPublished)
+	}
+	return [][2]string{
+		{"Posts", fmt.Sprint(stats.Total)},
+		{"Published", fmt.Sprint(stats.Published)},
+		{"Drafts", fmt.Sprint(stats.Drafts)},
+		{"Public", fmt.Sprint(stats.Public)},
+		{"Private", fmt.Sprint(stats.Private)},
+		{"Tags", fmt.Sprint(stats.Tags)},
+		{"Series", fmt.Sprint(stats.Series)},
+		{"Words", fmt.Sprint(stats.Words)},
+		{"Last published", lastPublished},
+	}
+}
+
+// renderStatsMarkdown formats the metrics as a markdown table.
+func renderStatsMarkdown(stats blogStats, dates dateFormatter) string {
+	var b strings.Builder
+	b.WriteString("| Metric | Value |\n")
+	b.WriteString("|--------|-------|\n")
+	for _, row := range statsRows(stats, dates) {
+		fmt.Fprintf(&b, "| %s | %s |\n", row[0], row[1])
+	}
+	return b.String()
+}
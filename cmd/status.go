@@ -0,0 +1,170 @@
+// cmd/status.go
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show which posts need attention",
+	Long: `Show the working state of your blog, like 'git status'.
+
+Posts are grouped into drafts that haven't been published, published
+posts with local changes since the last publish, and (with --remote)
+published posts whose gist no longer exists on GitHub.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		remote, _ := cmd.Flags().GetBool("remote")
+		return showStatus(remote)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(statusCmd)
+	statusCmd.Flags().Bool("remote", false, "Also check that published gists still exist on GitHub")
+}
+
+func showStatus(remote bool) error {
+	// Check if gblog is initialized
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+
+	posts, err := loadPosts()
+	if err != nil {
+		return err
+	}
+
+	if remote {
+		if err := checkGHAuth(); err != nil {
+			return err
+		}
+	}
+
+	var drafts, modified, missing []PostInfo
+	upToDate := 0
+	for _, post := range posts {
+		if post.Meta.RemoteID == "" {
+			drafts = append(drafts, post)
+			continue
+		}
+
+		if remote {
+			if _, err := fetchGist(post.Meta.RemoteID); err != nil {
+				if isNotFound(err) {
+					missing = append(missing, post)
+					continue
+				}
+				fmt.Fprintf(os.Stderr, "Warning: could not check gist for %s: %v\n", post.Meta.ID, err)
+			}
+		}
+
+		changed, err := postModified(filepath.Join("posts", post.Dir), post.Meta)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not check %s: %v\n", post.Meta.ID, err)
+			continue
+		}
+		if changed {
+			modified = append(modified, post)
+		} else {
+			upToDate++
+		}
+	}
+
+	fmt.Println(listTitleStyle.Render("📋 Blog Status"))
+
+	printStatusGroup("Drafts (not yet published):", draftColor.Render("draft"), drafts,
+		"gblog publish <id>")
+	printStatusGroup("Modified since last publish:", publishedColor.Render("modified"), modified,
+		"gblog publish <id> --update")
+	if remote {
+		printStatusGroup("Gist missing on GitHub:", privateColor.Render("missing"), missing,
+			"gblog publish <id> after clearing remote_id in .meta.json")
+	}
+
+	if len(drafts)+len(modified)+len(missing) == 0 {
+		fmt.Println("✅ Everything is published and up to date")
+	}
+
+	fmt.Println()
+	fmt.Printf("Up to date: %d | Modified: %d | Drafts: %d", upToDate, len(modified), len(drafts))
+	if remote {
+		fmt.Printf(" | Missing: %d", len(missing))
+	}
+	fmt.Println()
+
+	return nil
+}
+
+func printStatusGroup(heading, label string, posts []PostInfo, hint string) {
+	if len(posts) == 0 {
+		return
+	}
+
+	fmt.Println(heading)
+	fmt.Println(helpStyle.UnsetMargins().Render(fmt.Sprintf("  (use \"%s\")", hint)))
+	for _, post := range posts {
+		fmt.Printf("  %-10s %s  %s\n", label+":", post.Meta.ID, post.Meta.Title)
+	}
+	fmt.Println()
+}
+
+// postModified reports whether a published post changed after its last
+// publish, based on file mtimes and an explicit UpdatedAt. Posts published
+// before PublishedAt was recorded fall back to the .meta.json mtime.
+func postModified(postDir string, meta PostMeta) (bool, error) {
+	var lastPublish time.Time
+	if meta.PublishedAt != nil {
+		lastPublish = *meta.PublishedAt
+	} else {
+		info, err := os.Stat(filepath.Join(postDir, ".meta.json"))
+		if err != nil {
+			return false, err
+		}
+		lastPublish = info.ModTime()
+	}
+
+	if meta.UpdatedAt != nil && meta.UpdatedAt.After(lastPublish) {
+		return true, nil
+	}
+
+	latest, err := latestFileChange(postDir)
+	if err != nil {
+		return false, err
+	}
+	return latest.After(lastPublish), nil
+}
+
+// latestFileChange returns the newest mtime among the post's publishable files.
+func latestFileChange(postDir string) (time.Time, error) {
+	var latest time.Time
+
+	files, err := getGistFiles(postDir)
+	if err != nil {
+		return latest, err
+	}
+
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return latest, err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+
+	return latest, nil
+}
+
+// isNotFound reports whether a gh api error was an HTTP 404.
+func isNotFound(err error) bool {
+	return err != nil && (strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "HTTP 404"))
+}
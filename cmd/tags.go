@@ -0,0 +1,139 @@
+// cmd/tags.go
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+var tagsCmd = &cobra.Command{
+	Use:   "tags",
+	Short: "List all tags with post counts",
+	Long: `List every tag used across your posts with the number of posts
+carrying it, most used first. Use --alpha to sort alphabetically.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		alpha, _ := cmd.Flags().GetBool("alpha")
+		return listTags(alpha)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(tagsCmd)
+	tagsCmd.Flags().Bool("alpha", false, "Sort tags alphabetically instead of by count")
+}
+
+type tagCount struct {
+	Tag   string
+	Count int
+}
+
+func listTags(alpha bool) error {
+	// Check if gblog is initialized
+	if _, err := os.Stat(".gblog/config.json"); os.IsNotExist(err) {
+		return errNotInitialized()
+	}
+
+	posts, err := loadPosts()
+	if err != nil {
+		return err
+	}
+
+	counts := countTags(posts)
+	if len(counts) == 0 {
+		fmt.Println("No tags found. Tag posts with 'gblog new --tag <name>'")
+		return nil
+	}
+
+	if alpha {
+		sort.Slice(counts, func(i, j int) bool {
+			return counts[i].Tag < counts[j].Tag
+		})
+	}
+
+	countStyle := lipgloss.NewStyle().Bold(true).Foreground(inputStyle.GetBorderTopForeground())
+
+	fmt.Println(listTitleStyle.Render("🏷️  Tags"))
+	fmt.Println()
+	for _, tc := range counts {
+		fmt.Printf("%-30s %s\n", tc.Tag, countStyle.Render(fmt.Sprintf("%d", tc.Count)))
+	}
+	fmt.Println()
+	fmt.Printf("Total: %d tags\n", len(counts))
+
+	return nil
+}
+
+// countTags aggregates tag frequencies, most used first (ties alphabetical).
+func countTags(posts []PostInfo) []tagCount {
+	freq := map[string]int{}
+	for _, post := range posts {
+		for _, tag := range post.Meta.Tags {
+			freq[normalizeTag(tag)]++
+		}
+	}
+
+	counts := make([]tagCount, 0, len(freq))
+	for tag, count := range freq {
+		counts = append(counts, tagCount{Tag: tag, Count: count})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Tag < counts[j].Tag
+	})
+
+	return counts
+}
+
+// normalizeTag lowercases and trims a tag.
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// normalizeTags normalizes, de-duplicates, and drops empty tags.
+func normalizeTags(tags []string) []string {
+	seen := map[string]bool{}
+	var result []string
+	for _, tag := range tags {
+		tag = normalizeTag(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+	return result
+}
+
+// hasTag reports whether the post is tagged with tag (already normalized).
+func hasTag(meta PostMeta, tag string) bool {
+	for _, t := range meta.Tags {
+		if normalizeTag(t) == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// completeTags offers existing tags for shell completion of --tag flags.
+func completeTags(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	posts, err := loadPosts()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var tags []string
+	for _, tc := range countTags(posts) {
+		if strings.HasPrefix(tc.Tag, strings.ToLower(toComplete)) {
+			tags = append(tags, tc.Tag)
+		}
+	}
+	return tags, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,118 @@
+// cmd/textedit.go
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// textEditorModel is a minimal in-terminal editor for a single file, used
+// when no $EDITOR or GUI is available.
+type textEditorModel struct {
+	path     string
+	textarea textarea.Model
+	original string
+	saved    bool // the file was written at least once
+	err      error
+}
+
+func newTextEditorModel(path, content string) textEditorModel {
+	ta := textarea.New()
+	ta.SetValue(content)
+	ta.ShowLineNumbers = true
+	ta.CharLimit = 0
+	ta.MaxHeight = 0
+	ta.Focus()
+
+	return textEditorModel{
+		path:     path,
+		textarea: ta,
+		original: content,
+	}
+}
+
+func (m textEditorModel) Init() tea.Cmd {
+	return textarea.Blink
+}
+
+func (m textEditorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.textarea.SetWidth(msg.Width)
+		m.textarea.SetHeight(max(msg.Height-4, 3))
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			// Quit without saving pending changes
+			return m, tea.Quit
+		case "ctrl+s":
+			m.save()
+			return m, nil
+		case "esc":
+			m.save()
+			if m.err != nil {
+				return m, nil
+			}
+			return m, tea.Quit
+		}
+	}
+
+	var cmd tea.Cmd
+	m.textarea, cmd = m.textarea.Update(msg)
+	return m, cmd
+}
+
+// save writes the buffer to disk when it differs from the file.
+func (m *textEditorModel) save() {
+	value := m.textarea.Value()
+	if value == m.original {
+		return
+	}
+	if err := os.WriteFile(m.path, []byte(value), 0644); err != nil {
+		m.err = fmt.Errorf("failed to save: %w", err)
+		return
+	}
+	m.original = value
+	m.saved = true
+	m.err = nil
+}
+
+func (m textEditorModel) View() string {
+	status := "unchanged"
+	switch {
+	case m.textarea.Value() != m.original:
+		status = "modified"
+	case m.saved:
+		status = "saved"
+	}
+
+	s := titleStyle.Render(fmt.Sprintf("📝 %s (%s)", m.path, status)) + "\n"
+	s += m.textarea.View() + "\n"
+	if m.err != nil {
+		s += errorStyle.Render(m.err.Error()) + "\n"
+	}
+	s += helpStyle.UnsetMargins().Render("ctrl+s: save • esc: save and quit • ctrl+c: quit without saving")
+	return s
+}
+
+// editInTerminal edits path with the built-in editor and reports whether
+// the file was saved.
+func editInTerminal(path string) (bool, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	p := tea.NewProgram(newTextEditorModel(path, string(content)), tea.WithAltScreen())
+	finalModel, err := p.Run()
+	if err != nil {
+		return false, fmt.Errorf("editor failed: %w", err)
+	}
+
+	return finalModel.(textEditorModel).saved, nil
+}
@@ -0,0 +1,65 @@
+// cmd/theme.go
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// defaultTheme holds the built-in colors, keyed by theme name.
+var defaultTheme = map[string]string{
+	"title":     "#7C3AED",
+	"accent":    "#7C3AED",
+	"published": "#22C55E",
+	"draft":     "#F59E0B",
+	"private":   "#EF4444",
+	"help":      "#666666",
+	"error":     "#FF0000",
+}
+
+// themeKeys returns the supported theme keys in sorted order.
+func themeKeys() []string {
+	keys := make([]string, 0, len(defaultTheme))
+	for key := range defaultTheme {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// validateTheme checks that every key in theme is a known theme color.
+func validateTheme(theme map[string]string) error {
+	for key, value := range theme {
+		if _, ok := defaultTheme[key]; !ok {
+			return fmt.Errorf("unknown theme key %q (valid keys: %s)", key, strings.Join(themeKeys(), ", "))
+		}
+		if strings.TrimSpace(value) == "" {
+			return fmt.Errorf("theme color for %q cannot be empty", key)
+		}
+	}
+	return nil
+}
+
+// applyTheme overrides the package styles with colors from the config.
+// Keys missing from theme keep their default colors.
+func applyTheme(theme map[string]string) {
+	color := func(key string) lipgloss.Color {
+		if value, ok := theme[key]; ok && value != "" {
+			return lipgloss.Color(value)
+		}
+		return lipgloss.Color(defaultTheme[key])
+	}
+
+	titleStyle = titleStyle.Foreground(color("title"))
+	listTitleStyle = listTitleStyle.Foreground(color("title"))
+	inputStyle = inputStyle.BorderForeground(color("accent"))
+	helpStyle = helpStyle.Foreground(color("help"))
+	errorStyle = errorStyle.Foreground(color("error"))
+	commentAuthorStyle = commentAuthorStyle.Foreground(color("accent"))
+	publishedColor = publishedColor.Foreground(color("published"))
+	draftColor = draftColor.Foreground(color("draft"))
+	privateColor = privateColor.Foreground(color("private"))
+}
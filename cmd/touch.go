@@ -0,0 +1,55 @@
+// cmd/touch.go
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var touchCmd = &cobra.Command{
+	Use:   "touch <post-id>...",
+	Short: "Mark posts as changed so they get re-published",
+	Long: `Set a post's updated_at to now, so 'gblog status' and
+'gblog list --modified' show it as needing a re-publish even when its
+file times don't (for example after a git checkout).`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, postID := range args {
+			if err := touchPost(postID); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(touchCmd)
+}
+
+func touchPost(postID string) error {
+	postDir, err := findPostDir(postID)
+	if err != nil {
+		return err
+	}
+
+	meta, err := loadPostMeta(postDir)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	meta.UpdatedAt = &now
+	if err := savePostMeta(postDir, meta); err != nil {
+		return err
+	}
+
+	if meta.RemoteID == "" {
+		logInfo(fmt.Sprintf("👆 Touched %s (not published yet)", meta.ID), "post_id", meta.ID)
+		return nil
+	}
+	logInfo(fmt.Sprintf("👆 Touched %s; run 'gblog publish %s --update' to re-publish it", meta.ID, meta.ID), "post_id", meta.ID)
+	return nil
+}
@@ -0,0 +1,147 @@
+// cmd/undo.go
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+)
+
+var undoPublishCmd = &cobra.Command{
+	Use:   "undo-publish <post-id>",
+	Short: "Roll a gist back to its previous revision",
+	Long: `Roll a published post's gist back to its previous revision.
+
+This fetches the gist history, restores the files from the revision
+before the latest one, and removes files that didn't exist then.
+Use --sync-local to also write the restored contents to the post directory.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		yes, _ := cmd.Flags().GetBool("yes")
+		syncLocal, _ := cmd.Flags().GetBool("sync-local")
+		return undoPublish(args[0], yes, syncLocal)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(undoPublishCmd)
+	undoPublishCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	undoPublishCmd.Flags().Bool("sync-local", false, "Also write the restored files to the post directory")
+}
+
+func undoPublish(postID string, yes, syncLocal bool) error {
+	postDir, err := findPostDir(postID)
+	if err != nil {
+		return err
+	}
+
+	meta, err := loadPostMeta(postDir)
+	if err != nil {
+		return err
+	}
+
+	if meta.RemoteID == "" {
+		return fmt.Errorf("post %s has not been published", meta.ID)
+	}
+	if meta.Anonymous {
+		return fmt.Errorf("post %s was published anonymously and its gist can't be edited", meta.ID)
+	}
+
+	if err := checkGHAuth(); err != nil {
+		return err
+	}
+
+	current, err := fetchGist(meta.RemoteID)
+	if err != nil {
+		return err
+	}
+
+	if len(current.History) < 2 {
+		return fmt.Errorf("gist %s has no previous revision to restore", meta.RemoteID)
+	}
+
+	previous := current.History[1]
+	revision, err := fetchGistRevision(meta.RemoteID, previous.Version)
+	if err != nil {
+		return err
+	}
+
+	// Restore every file from the previous revision, delete newer ones
+	files := map[string]*gistFile{}
+	var restored, removed []string
+	for name, file := range revision.Files {
+		if file.Truncated {
+			return fmt.Errorf("file %s is too large to restore through the API", name)
+		}
+		f := file
+		files[name] = &f
+		restored = append(restored, name)
+	}
+	for name := range current.Files {
+		if _, ok := revision.Files[name]; !ok {
+			files[name] = nil
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(restored)
+	sort.Strings(removed)
+
+	logInfo(fmt.Sprintf("⏪ Restoring gist %s to revision %s (%s)",
+		meta.RemoteID, shortSHA(previous.Version), previous.CommittedAt.Local().Format("2006-01-02 15:04")),
+		"post_id", meta.ID, "gist_id", meta.RemoteID, "revision", previous.Version)
+	logInfo(fmt.Sprintf("Restore: %v", restored), "post_id", meta.ID, "files", restored)
+	if len(removed) > 0 {
+		logInfo(fmt.Sprintf("Remove: %v", removed), "post_id", meta.ID, "files", removed)
+	}
+
+	if !yes {
+		ok, err := confirm("Roll back the gist?")
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	description := revision.Description
+	restoredRevision, err := patchGist(meta.RemoteID, &description, files)
+	if err != nil {
+		return fmt.Errorf("failed to restore gist: %w", err)
+	}
+
+	// The rollback is gblog's own change, not a remote edit
+	meta.RemoteRevision = restoredRevision
+	if err := savePostMeta(postDir, meta); err != nil {
+		return err
+	}
+
+	if syncLocal {
+		for _, name := range restored {
+			path := filepath.Join(postDir, name)
+			if err := os.WriteFile(path, []byte(revision.Files[name].Content), 0644); err != nil {
+				return fmt.Errorf("failed to write %s: %w", path, err)
+			}
+		}
+		logInfo(fmt.Sprintf("📁 Synced %d file(s) to %s", len(restored), postDir), "post_id", meta.ID, "dir", postDir)
+	}
+
+	recordHistory("undo-publish", meta.ID, meta.RemoteID, shortSHA(previous.Version))
+
+	logInfo(fmt.Sprintf("✅ Gist rolled back to revision %s", shortSHA(previous.Version)), "post_id", meta.ID, "gist_id", meta.RemoteID)
+	logInfo(fmt.Sprintf("🔗 Gist URL: %s", meta.RemoteURL), "post_id", meta.ID, "gist_url", meta.RemoteURL)
+
+	return nil
+}
+
+// shortSHA abbreviates a revision SHA for display.
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
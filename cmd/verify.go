@@ -0,0 +1,145 @@
+// cmd/verify.go
+package cmd
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// checksumsFileName is the manifest 'export --checksums' adds to archives.
+const checksumsFileName = "checksums.sha256"
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify <archive>",
+	Short: "Check an export archive against its checksums",
+	Long: `Recompute the SHA-256 of every file in an archive written by
+'gblog export --checksums' and compare it with checksums.sha256.
+
+Files that changed, went missing, or aren't listed in the manifest are
+reported, and the command fails if there are any. Decrypt encrypted
+archives with 'gblog import --decrypt' first.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return verifyArchive(args[0])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(verifyCmd)
+}
+
+func verifyArchive(path string) error {
+	if header, err := readFileHeader(path, len(encryptMagic)); err == nil && string(header) == encryptMagic {
+		return fmt.Errorf("%s is encrypted; decrypt it with 'gblog import --decrypt' first", path)
+	}
+
+	reader, err := zip.OpenReader(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer reader.Close()
+
+	// Hash every file in the archive except the manifest itself
+	actual := map[string]string{}
+	var manifest []byte
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := file.Open()
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", file.Name, err)
+		}
+		if file.Name == checksumsFileName {
+			manifest, err = io.ReadAll(rc)
+		} else {
+			hash := sha256.New()
+			_, err = io.Copy(hash, rc)
+			actual[file.Name] = fmt.Sprintf("%x", hash.Sum(nil))
+		}
+		rc.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", file.Name, err)
+		}
+	}
+
+	if manifest == nil {
+		return fmt.Errorf("%s has no %s; export it with --checksums", path, checksumsFileName)
+	}
+
+	expected, err := parseChecksums(manifest)
+	if err != nil {
+		return err
+	}
+
+	var problems []string
+	for name, sum := range expected {
+		got, ok := actual[name]
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("missing:  %s", name))
+		case got != sum:
+			problems = append(problems, fmt.Sprintf("modified: %s", name))
+		}
+	}
+	for name := range actual {
+		if _, ok := expected[name]; !ok {
+			problems = append(problems, fmt.Sprintf("unlisted: %s", name))
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		for _, problem := range problems {
+			fmt.Printf("❌ %s\n", problem)
+		}
+		return fmt.Errorf("%s failed verification: %d problem(s)", path, len(problems))
+	}
+
+	fmt.Printf("✅ %s: all %d files match their checksums\n", path, len(expected))
+	return nil
+}
+
+// parseChecksums reads a sha256sum-style manifest into a map from file
+// name to hex digest.
+func parseChecksums(data []byte) (map[string]string, error) {
+	sums := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		sum, name, ok := strings.Cut(text, "  ")
+		if !ok || len(sum) != sha256.Size*2 {
+			return nil, fmt.Errorf("%s line %d is malformed", checksumsFileName, line)
+		}
+		sums[name] = sum
+	}
+	return sums, scanner.Err()
+}
+
+// readFileHeader returns up to the first n bytes of the file at path.
+func readFileHeader(path string, n int) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	header := make([]byte, n)
+	read, err := io.ReadFull(file, header)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	return header[:read], nil
+}
@@ -0,0 +1,118 @@
+// cmd/watch.go
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/cobra"
+)
+
+var watchCmd = &cobra.Command{
+	Use:   "watch <post-id>",
+	Short: "Update a post's gist whenever its files change",
+	Long: `Watch a published post's directory and run 'publish --update' each
+time its files change, for live-blogging and quick iteration.
+
+Changes are debounced (--debounce, 1s by default) so a burst of saves
+causes a single update. Hidden files such as .meta.json are ignored.
+Press Ctrl+C to stop.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		debounce, _ := cmd.Flags().GetDuration("debounce")
+		return watchPost(args[0], debounce)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+	watchCmd.Flags().Duration("debounce", time.Second, "Wait this long after the last change before updating")
+}
+
+func watchPost(postID string, debounce time.Duration) error {
+	postDir, err := findPostDir(postID)
+	if err != nil {
+		return err
+	}
+
+	meta, err := loadPostMeta(postDir)
+	if err != nil {
+		return err
+	}
+
+	if meta.RemoteID == "" {
+		return fmt.Errorf("post %s has not been published; run 'gblog publish %s' first", meta.ID, meta.ID)
+	}
+	// Updating an anonymous post would create a new gist on every save
+	if meta.Anonymous {
+		return fmt.Errorf("post %s was published anonymously and its gist can't be updated", meta.ID)
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to start watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(postDir); err != nil {
+		return fmt.Errorf("failed to watch %s: %w", postDir, err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	logInfo(fmt.Sprintf("👀 Watching %s (Ctrl+C to stop)", postDir), "post_id", meta.ID)
+
+	// A nil channel blocks, so nothing fires until a change arms the timer
+	var timer *time.Timer
+	var fire <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			logInfo("\n👋 Stopped watching")
+			return nil
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if strings.HasPrefix(filepath.Base(event.Name), ".") || event.Op == fsnotify.Chmod {
+				continue
+			}
+			if timer == nil {
+				timer = time.NewTimer(debounce)
+			} else {
+				timer.Reset(debounce)
+			}
+			fire = timer.C
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			logWarnStderr(fmt.Sprintf("Warning: watch error: %v", err))
+
+		case <-fire:
+			fire = nil
+			// JSON records carry their own timestamp
+			if logger != nil {
+				logger.Info("change detected, updating gist", "post_id", meta.ID)
+			} else {
+				fmt.Printf("\n[%s] 🔄 Change detected, updating gist...\n", time.Now().Format("15:04:05"))
+			}
+			if err := publishPost(meta.ID, publishOptions{update: true, noBrowser: true}); err != nil {
+				if logger != nil {
+					logger.Warn("update failed", "post_id", meta.ID, "error", err.Error())
+				} else {
+					fmt.Fprintf(os.Stderr, "[%s] ❌ Update failed: %v\n", time.Now().Format("15:04:05"), err)
+				}
+			}
+		}
+	}
+}